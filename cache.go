@@ -0,0 +1,659 @@
+package linodego
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// APIDefaultCacheMaxEntries is the maximum number of responses
+// held by a Client's default in-memory cache.
+const APIDefaultCacheMaxEntries = 1000
+
+// Cache is a store for cached API responses.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored under the given key, if any.
+	Get(key string) (CacheEntry, bool)
+	// Set stores the given entry under the given key.
+	Set(key string, entry CacheEntry)
+	// Delete removes the entry stored under the given key.
+	Delete(key string)
+	// Clear removes all entries from the cache.
+	Clear()
+	// Keys returns the keys of all entries currently in the cache.
+	Keys() []string
+	// Len returns the number of entries currently in the cache.
+	Len() int
+	// Evictions returns the number of entries that have been evicted
+	// to keep the cache within its size bounds.
+	Evictions() uint64
+}
+
+// CacheEntry is a single cached API response.
+type CacheEntry struct {
+	Created time.Time
+	Data    any
+	// If != nil, use this instead of the
+	// global expiry
+	ExpiryOverride *time.Duration
+}
+
+// CacheStats contains counters describing the usage of a Client's response cache.
+type CacheStats struct {
+	Hits        uint64
+	Misses      uint64
+	StaleHits   uint64
+	Expirations uint64
+	Evictions   uint64
+	Entries     int
+}
+
+// clientCacheState contains the cache state shared between copies of a Client.
+type clientCacheState struct {
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	staleHits   atomic.Uint64
+	expirations atomic.Uint64
+
+	// Keys currently being revalidated in the background
+	revalidating sync.Map
+}
+
+// CacheDependency declares endpoints whose cached responses should be invalidated
+// when a mutating request is made to a matching endpoint.
+type CacheDependency struct {
+	// Method is the HTTP method of the mutating request (e.g. POST).
+	// If empty, all mutating methods will match.
+	Method string
+
+	// Endpoint is a pattern matched against the endpoint of the mutating request
+	// using path.Match (e.g. "linode/instances/*").
+	Endpoint string
+
+	// Invalidates contains the endpoints to invalidate when this dependency matches.
+	Invalidates []string
+}
+
+// defaultCacheDependencies contains the dependencies between endpoints
+// that are not implied by the path of the mutating request.
+var defaultCacheDependencies = []CacheDependency{
+	{
+		Method:      http.MethodPost,
+		Endpoint:    "linode/instances",
+		Invalidates: []string{"networking/ips", "tags"},
+	},
+	{
+		Method:      http.MethodDelete,
+		Endpoint:    "linode/instances/*",
+		Invalidates: []string{"networking/ips", "tags", "volumes"},
+	},
+	{
+		Method:      http.MethodPost,
+		Endpoint:    "linode/instances/*/ips",
+		Invalidates: []string{"networking/ips"},
+	},
+}
+
+// invalidateCacheForRequest invalidates all cached responses that may have been
+// affected by a successful mutating request to the given endpoint.
+// This includes the endpoint itself, each of its parents, all cached list
+// variants of these endpoints and any declared dependent endpoints.
+func (c *Client) invalidateCacheForRequest(method, endpoint string) {
+	if c.cache == nil || method == http.MethodGet {
+		return
+	}
+
+	endpoint = strings.Trim(endpoint, "/")
+
+	var affected []string
+
+	for p := endpoint; p != "." && p != "/" && p != ""; p = path.Dir(p) {
+		affected = append(affected, p)
+	}
+
+	for _, dep := range c.cacheDependencies {
+		if dep.Method != "" && !strings.EqualFold(dep.Method, method) {
+			continue
+		}
+
+		if ok, _ := path.Match(dep.Endpoint, endpoint); ok {
+			affected = append(affected, dep.Invalidates...)
+		}
+	}
+
+	c.invalidateCachePaths(affected...)
+}
+
+// invalidateCachePaths invalidates the cached responses for the given
+// endpoints, including responses for any of their list variants.
+func (c *Client) invalidateCachePaths(paths ...string) {
+	if c.cache == nil || len(paths) == 0 {
+		return
+	}
+
+	// Responses are invalidated regardless of the scope they were cached under,
+	// as other clients sharing the cache may be affected by the change
+	for _, key := range c.cache.Keys() {
+		// Strip the hashed ListOptions suffix added by generateListCacheURL
+		keyPath, _, _ := strings.Cut(cacheKeyEndpoint(key), ":")
+		keyPath = strings.Trim(keyPath, "/")
+
+		for _, p := range paths {
+			if keyPath == strings.Trim(p, "/") {
+				c.cache.Delete(key)
+				break
+			}
+		}
+	}
+}
+
+// cacheKey returns the key under which the response for the given endpoint is cached.
+// Keys are scoped to the API URL and credentials used by the client, so clients
+// sharing a Cache never receive responses fetched using other credentials.
+func (c *Client) cacheKey(ctx context.Context, endpoint string) (string, error) {
+	// The scope depends on the profile, which may not have been loaded yet
+	if err := c.loadSelectedProfile(); err != nil {
+		return "", err
+	}
+
+	authorization, err := c.tokenSource.authorization(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if authorization == "" {
+		authorization = c.resty.Header.Get("Authorization")
+	}
+
+	scope := sha256.Sum256([]byte(c.resty.BaseURL + "\n" + authorization))
+
+	return hex.EncodeToString(scope[:8]) + "|" + endpoint, nil
+}
+
+// cacheKeyEndpoint returns the endpoint of the given cache key without its scope.
+func cacheKeyEndpoint(key string) string {
+	if _, endpoint, ok := strings.Cut(key, "|"); ok {
+		return endpoint
+	}
+
+	return key
+}
+
+type cacheRevalidationKey struct{}
+
+// isCacheRevalidation returns whether the given context belongs
+// to a background cache revalidation.
+func isCacheRevalidation(ctx context.Context) bool {
+	v, ok := ctx.Value(cacheRevalidationKey{}).(bool)
+	return ok && v
+}
+
+// doCachedRequest returns the cached response for the given key if one is available,
+// otherwise the response is fetched and added to the cache.
+// If the cached response has expired but is still within the client's stale-while-revalidate
+// window, the stale response is returned and refreshed in the background.
+func doCachedRequest[T any](
+	ctx context.Context,
+	client *Client,
+	key string,
+	expiry *time.Duration,
+	fetch func(ctx context.Context) (T, error),
+) (T, error) {
+	if requestOptionsFromContext(ctx).skipCache() {
+		return fetch(ctx)
+	}
+
+	key, err := client.cacheKey(ctx, key)
+	if err != nil {
+		var result T
+		return result, err
+	}
+
+	if result, status := getCachedResponse[T](client, key); status != cacheMiss {
+		if status == cacheStale {
+			client.revalidateCachedResponse(ctx, key, expiry, func(ctx context.Context) (any, error) {
+				return fetch(ctx)
+			})
+		}
+
+		return result, nil
+	}
+
+	response, err := fetch(ctx)
+	if err != nil {
+		return response, err
+	}
+
+	client.addCachedResponse(key, response, expiry)
+
+	return response, nil
+}
+
+type cacheStatus int
+
+const (
+	cacheMiss cacheStatus = iota
+	cacheHit
+	cacheStale
+)
+
+func getCachedResponse[T any](c *Client, key string) (T, cacheStatus) {
+	var result T
+
+	if !c.shouldCache || c.cache == nil {
+		return result, cacheMiss
+	}
+
+	entry, ok := c.cache.Get(key)
+	if !ok {
+		c.cacheState.misses.Add(1)
+		return result, cacheMiss
+	}
+
+	value, ok := cacheEntryValue[T](entry.Data)
+	if !ok {
+		// The entry cannot be used for the requested type
+		c.cache.Delete(key)
+		c.cacheState.misses.Add(1)
+		return result, cacheMiss
+	}
+
+	// Handle expired entries
+	elapsedTime := time.Since(entry.Created)
+
+	expiry := c.cacheExpiration
+	if entry.ExpiryOverride != nil {
+		expiry = *entry.ExpiryOverride
+	}
+
+	switch {
+	case elapsedTime <= expiry:
+		c.cacheState.hits.Add(1)
+		return value, cacheHit
+	case elapsedTime <= expiry+c.cacheStaleWindow:
+		c.cacheState.staleHits.Add(1)
+		return value, cacheStale
+	default:
+		c.cache.Delete(key)
+		c.cacheState.expirations.Add(1)
+		c.cacheState.misses.Add(1)
+		return result, cacheMiss
+	}
+}
+
+// revalidateCachedResponse refreshes the cached response for the given key in the background.
+// Only one revalidation per key will be in flight at any given time.
+func (c *Client) revalidateCachedResponse(
+	ctx context.Context,
+	key string,
+	expiry *time.Duration,
+	fetch func(ctx context.Context) (any, error),
+) {
+	if _, loaded := c.cacheState.revalidating.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	// The revalidation should outlive the request that triggered it
+	ctx = context.WithValue(context.WithoutCancel(ctx), cacheRevalidationKey{}, true)
+
+	go func() {
+		defer c.cacheState.revalidating.Delete(key)
+
+		response, err := fetch(ctx)
+		if err != nil {
+			c.logState.get().Warn("Failed to revalidate cached response", "key", key, "error", err)
+			return
+		}
+
+		c.addCachedResponse(key, response, expiry)
+	}()
+}
+
+// cacheEntryValue converts the data of a cache entry to the given type.
+// Entries are stored dereferenced, and may be stored as raw JSON by
+// persistent caches.
+func cacheEntryValue[T any](data any) (T, bool) {
+	var result T
+
+	switch d := data.(type) {
+	case T:
+		return d, true
+	case json.RawMessage:
+		if err := json.Unmarshal(d, &result); err != nil {
+			return result, false
+		}
+
+		return result, true
+	}
+
+	resultValue := reflect.ValueOf(&result).Elem()
+	dataValue := reflect.ValueOf(data)
+
+	if resultValue.Kind() != reflect.Ptr || !dataValue.IsValid() ||
+		dataValue.Type() != resultValue.Type().Elem() {
+		return result, false
+	}
+
+	ptr := reflect.New(dataValue.Type())
+	ptr.Elem().Set(dataValue)
+	resultValue.Set(ptr)
+
+	return result, true
+}
+
+// LRUCache is an in-memory Cache bounded to a maximum number of entries.
+// When the cache is full, the least recently used entry is evicted.
+type LRUCache struct {
+	maxEntries int
+
+	mu        sync.Mutex
+	entries   map[string]*list.Element
+	order     *list.List
+	evictions uint64
+}
+
+type lruCacheItem struct {
+	key   string
+	entry CacheEntry
+}
+
+var _ Cache = (*LRUCache)(nil)
+
+// NewLRUCache creates a new LRUCache holding at most maxEntries entries.
+// If maxEntries <= 0, the cache will not be bounded.
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get returns the entry stored under the given key, if any.
+func (l *LRUCache) Get(key string) (CacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elem, ok := l.entries[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+
+	l.order.MoveToFront(elem)
+
+	return elem.Value.(*lruCacheItem).entry, true
+}
+
+// Set stores the given entry under the given key, evicting the least
+// recently used entry if the cache is full.
+func (l *LRUCache) Set(key string, entry CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.entries[key]; ok {
+		elem.Value.(*lruCacheItem).entry = entry
+		l.order.MoveToFront(elem)
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruCacheItem{key: key, entry: entry})
+
+	for l.maxEntries > 0 && l.order.Len() > l.maxEntries {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruCacheItem).key)
+		l.evictions++
+	}
+}
+
+// Delete removes the entry stored under the given key.
+func (l *LRUCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.entries[key]; ok {
+		l.order.Remove(elem)
+		delete(l.entries, key)
+	}
+}
+
+// Clear removes all entries from the cache.
+func (l *LRUCache) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = make(map[string]*list.Element)
+	l.order.Init()
+}
+
+// Keys returns the keys of all entries currently in the cache.
+func (l *LRUCache) Keys() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	result := make([]string, 0, len(l.entries))
+	for key := range l.entries {
+		result = append(result, key)
+	}
+
+	return result
+}
+
+// Len returns the number of entries currently in the cache.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
+
+// Evictions returns the number of entries evicted from the cache.
+func (l *LRUCache) Evictions() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.evictions
+}
+
+// DiskCache is a Cache that persists entries as JSON files in a directory,
+// allowing cached responses to be shared between runs of short-lived
+// programs such as CLI tools.
+//
+// Entries read from disk are decoded into the type requested by the Client.
+// A DiskCache may be shared between clients using different accounts,
+// as the keys of cached responses are scoped to the credentials used.
+type DiskCache struct {
+	dir    string
+	logger *slog.Logger
+
+	mu sync.Mutex
+}
+
+type diskCacheFile struct {
+	Key            string          `json:"key"`
+	Created        time.Time       `json:"created"`
+	ExpiryOverride *time.Duration  `json:"expiry_override,omitempty"`
+	Data           json.RawMessage `json:"data"`
+}
+
+var _ Cache = (*DiskCache)(nil)
+
+// NewDiskCache creates a new DiskCache storing entries in the given directory.
+// The directory will be created if it does not already exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory %s: %w", dir, err)
+	}
+
+	return &DiskCache{dir: dir, logger: slog.New(discardHandler{})}, nil
+}
+
+// SetSlogLogger sets the logger used to report entries that could not be written.
+func (d *DiskCache) SetSlogLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(discardHandler{})
+	}
+
+	d.logger = logger
+}
+
+// Get returns the entry stored under the given key, if any.
+func (d *DiskCache) Get(key string) (CacheEntry, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	var file diskCacheFile
+	if err := json.Unmarshal(data, &file); err != nil || file.Key != key {
+		return CacheEntry{}, false
+	}
+
+	return CacheEntry{
+		Created:        file.Created,
+		Data:           file.Data,
+		ExpiryOverride: file.ExpiryOverride,
+	}, true
+}
+
+// Set stores the given entry under the given key.
+// Entries that cannot be encoded as JSON are not stored.
+func (d *DiskCache) Set(key string, entry CacheEntry) {
+	data, err := json.Marshal(entry.Data)
+	if err != nil {
+		d.logger.Warn("Failed to encode cache entry", "key", key, "error", err)
+		return
+	}
+
+	file, err := json.Marshal(diskCacheFile{
+		Key:            key,
+		Created:        entry.Created,
+		ExpiryOverride: entry.ExpiryOverride,
+		Data:           data,
+	})
+	if err != nil {
+		d.logger.Warn("Failed to encode cache entry", "key", key, "error", err)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Write to a temporary file first so concurrent readers
+	// never observe a partially written entry
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		d.logger.Warn("Failed to write cache entry", "key", key, "error", err)
+		return
+	}
+
+	_, err = tmp.Write(file)
+	err = errors.Join(err, tmp.Close())
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), d.path(key))
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		d.logger.Warn("Failed to write cache entry", "key", key, "error", err)
+	}
+}
+
+// Delete removes the entry stored under the given key.
+func (d *DiskCache) Delete(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_ = os.Remove(d.path(key))
+}
+
+// Clear removes all entries from the cache.
+func (d *DiskCache) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, name := range d.entryFiles() {
+		_ = os.Remove(filepath.Join(d.dir, name))
+	}
+}
+
+// Keys returns the keys of all entries currently in the cache.
+func (d *DiskCache) Keys() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	names := d.entryFiles()
+	result := make([]string, 0, len(names))
+
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(d.dir, name))
+		if err != nil {
+			continue
+		}
+
+		var file diskCacheFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			continue
+		}
+
+		result = append(result, file.Key)
+	}
+
+	return result
+}
+
+// Len returns the number of entries currently in the cache.
+func (d *DiskCache) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.entryFiles())
+}
+
+// Evictions always returns 0 as DiskCache is not bounded.
+func (d *DiskCache) Evictions() uint64 {
+	return 0
+}
+
+func (d *DiskCache) path(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(h[:])+".json")
+}
+
+func (d *DiskCache) entryFiles() []string {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		result = append(result, entry.Name())
+	}
+
+	return result
+}
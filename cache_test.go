@@ -0,0 +1,224 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache_Eviction(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	cache.Set("a", CacheEntry{Data: 1})
+	cache.Set("b", CacheEntry{Data: 2})
+
+	// Access a so b becomes the least recently used entry
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Set("c", CacheEntry{Data: 3})
+
+	_, ok = cache.Get("b")
+	require.False(t, ok)
+
+	_, ok = cache.Get("a")
+	require.True(t, ok)
+
+	require.Equal(t, 2, cache.Len())
+	require.Equal(t, uint64(1), cache.Evictions())
+
+	cache.Delete("a")
+	require.Equal(t, 1, cache.Len())
+
+	cache.Clear()
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestCache_Stats(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/regions/us-east"),
+		httpmock.NewJsonResponderOrPanic(200, Region{ID: "us-east"}))
+
+	for range 3 {
+		region, err := client.GetRegion(context.Background(), "us-east")
+		require.NoError(t, err)
+		require.Equal(t, "us-east", region.ID)
+	}
+
+	stats := client.CacheStats()
+	require.Equal(t, uint64(2), stats.Hits)
+	require.Equal(t, uint64(1), stats.Misses)
+	require.Equal(t, 1, stats.Entries)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestCache_DiskCache(t *testing.T) {
+	dir := t.TempDir()
+
+	client := testutil.CreateMockClient(t, NewClient)
+
+	diskCache, err := NewDiskCache(dir)
+	require.NoError(t, err)
+	client.SetCache(diskCache)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/kernels/linode-latest"),
+		httpmock.NewJsonResponderOrPanic(200, LinodeKernel{ID: "linode/latest", Label: "Latest"}))
+
+	_, err = client.GetKernel(context.Background(), "linode-latest")
+	require.NoError(t, err)
+	require.Equal(t, 1, diskCache.Len())
+
+	// A new client should be able to read the persisted entry
+	otherClient := NewClient(nil)
+
+	otherDiskCache, err := NewDiskCache(dir)
+	require.NoError(t, err)
+	otherClient.SetCache(otherDiskCache)
+
+	kernel, err := otherClient.GetKernel(context.Background(), "linode-latest")
+	require.NoError(t, err)
+	require.Equal(t, "Latest", kernel.Label)
+	require.Equal(t, uint64(1), otherClient.CacheStats().Hits)
+
+	require.NoError(t, otherClient.InvalidateCacheEndpoint("linode/kernels/linode-latest"))
+	require.Equal(t, 0, diskCache.Len())
+}
+
+func TestCache_SharedBetweenAccounts(t *testing.T) {
+	cache := NewLRUCache(APIDefaultCacheMaxEntries)
+
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetCache(cache)
+	client.SetToken("account-a")
+
+	otherClient := testutil.CreateMockClient(t, NewClient)
+	otherClient.SetCache(cache)
+	otherClient.SetToken("account-b")
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/regions/us-east"),
+		httpmock.NewJsonResponderOrPanic(200, Region{ID: "us-east"}))
+
+	for _, c := range []*Client{client, client, otherClient, otherClient} {
+		_, err := c.GetRegion(context.Background(), "us-east")
+		require.NoError(t, err)
+	}
+
+	// Each account should have fetched its own response
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+	require.Equal(t, 2, cache.Len())
+
+	// Invalidating an endpoint should affect every account
+	require.NoError(t, client.InvalidateCacheEndpoint("regions/us-east"))
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestCache_StaleWhileRevalidate(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetGlobalCacheExpiration(0)
+	client.SetCacheStaleWhileRevalidate(time.Hour)
+
+	var numRequests atomic.Int64
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/kernels/linode-latest"),
+		func(request *http.Request) (*http.Response, error) {
+			numRequests.Add(1)
+			return httpmock.NewJsonResponse(200, LinodeKernel{ID: "linode/latest"})
+		})
+
+	_, err := client.GetKernel(context.Background(), "linode-latest")
+	require.NoError(t, err)
+
+	// The expired entry should be served while being refreshed in the background
+	kernel, err := client.GetKernel(context.Background(), "linode-latest")
+	require.NoError(t, err)
+	require.Equal(t, "linode/latest", kernel.ID)
+	require.Equal(t, uint64(1), client.CacheStats().StaleHits)
+
+	require.Eventually(t, func() bool {
+		return numRequests.Load() == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCache_InvalidateOnMutation(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	listKey, err := generateListCacheURL("linode/instances", &ListOptions{Filter: "{\"region\": \"us-east\"}"})
+	require.NoError(t, err)
+
+	scoped := func(endpoints ...string) []string {
+		result := make([]string, len(endpoints))
+		for i, endpoint := range endpoints {
+			key, err := client.cacheKey(context.Background(), endpoint)
+			require.NoError(t, err)
+			result[i] = key
+		}
+		return result
+	}
+
+	keys := scoped(
+		"linode/instances",
+		listKey,
+		"linode/instances/123",
+		"linode/instances/456",
+		"networking/ips",
+		"regions",
+	)
+
+	populate := func() {
+		client.InvalidateCache()
+		for _, key := range keys {
+			client.addCachedResponse(key, key, nil)
+		}
+	}
+
+	httpmock.RegisterRegexpResponder("PUT", testutil.MockRequestURL("/linode/instances/123"),
+		httpmock.NewJsonResponderOrPanic(200, Instance{ID: 123}))
+	httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/linode/instances"),
+		httpmock.NewJsonResponderOrPanic(200, Instance{ID: 789}))
+
+	populate()
+
+	_, err = client.UpdateInstance(context.Background(), 123, InstanceUpdateOptions{})
+	require.NoError(t, err)
+
+	require.ElementsMatch(
+		t,
+		scoped("linode/instances/456", "networking/ips", "regions"),
+		client.cache.Keys(),
+	)
+
+	populate()
+
+	_, err = client.CreateInstance(context.Background(), InstanceCreateOptions{})
+	require.NoError(t, err)
+
+	// Creating an instance should also invalidate its dependent endpoints
+	require.ElementsMatch(
+		t,
+		scoped("linode/instances/123", "linode/instances/456", "regions"),
+		client.cache.Keys(),
+	)
+
+	populate()
+
+	client.AddCacheDependencies(CacheDependency{
+		Endpoint:    "linode/instances/*",
+		Invalidates: []string{"regions"},
+	})
+
+	_, err = client.UpdateInstance(context.Background(), 123, InstanceUpdateOptions{})
+	require.NoError(t, err)
+
+	require.ElementsMatch(
+		t,
+		scoped("linode/instances/456", "networking/ips"),
+		client.cache.Keys(),
+	)
+}
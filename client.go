@@ -6,17 +6,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
-	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
-	"sync"
 	"text/template"
 	"time"
 
@@ -49,7 +48,6 @@ const (
 	APIDefaultCacheExpiration = time.Minute * 15
 )
 
-//nolint:unused
 var (
 	reqLogTemplate = template.Must(template.New("request").Parse(`Sending request:
 Method: {{.Method}}
@@ -63,7 +61,13 @@ Headers: {{.Headers}}
 Body: {{.Body}}`))
 )
 
-var envDebug = false
+var (
+	envDebug = false
+
+	// envLogger writes log messages to stderr, and is only used
+	// if the LINODE_DEBUG environment variable is set
+	envLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+)
 
 // Client is a wrapper around the Resty client
 type Client struct {
@@ -72,7 +76,8 @@ type Client struct {
 	debug             bool
 	retryConditionals []RetryConditional
 
-	pollInterval time.Duration
+	pollInterval    time.Duration
+	pageConcurrency int
 
 	baseURL         string
 	apiVersion      string
@@ -82,11 +87,48 @@ type Client struct {
 
 	configProfiles map[string]ConfigProfile
 
+	removedProfiles    []string
+	useProfileDefaults bool
+	profileBaseline    *profileBaseline
+
+	// envErr is the error encountered when applying environment variables in NewClient
+	envErr error
+
 	// Fields for caching endpoint responses
-	shouldCache     bool
-	cacheExpiration time.Duration
-	cachedEntries   map[string]clientCacheEntry
-	cachedEntryLock *sync.RWMutex
+	shouldCache       bool
+	cacheExpiration   time.Duration
+	cacheStaleWindow  time.Duration
+	cache             Cache
+	cacheState        *clientCacheState
+	cacheDependencies []CacheDependency
+
+	// Fields for the net/http execution path
+	http    *httpClient
+	useHTTP bool
+
+	rateLimit *rateLimitState
+	logState  *clientLogState
+	telemetry *clientTelemetry
+
+	retryState  *clientRetryState
+	maintenance *maintenanceState
+	tokenSource *tokenSourceState
+	dryRun      *dryRunState
+
+	// Handlers registered by the user, kept so they can be
+	// registered with clients derived using With
+	hooks clientHooks
+}
+
+// clientHooks are the handlers and callbacks registered with a Client by the user.
+type clientHooks struct {
+	beforeRequest     []func(*Request) error
+	afterResponse     []func(*Response) error
+	beforeHTTPRequest []func(*http.Request) error
+	afterHTTPResponse []func(*http.Response) error
+	retryConditions   []RetryConditional
+	retryAfter        RetryAfter
+	logger            Logger
 }
 
 type EnvDefaults struct {
@@ -94,14 +136,6 @@ type EnvDefaults struct {
 	Profile string
 }
 
-type clientCacheEntry struct {
-	Created time.Time
-	Data    any
-	// If != nil, use this instead of the
-	// global expiry
-	ExpiryOverride *time.Duration
-}
-
 type (
 	Request  = resty.Request
 	Response = resty.Response
@@ -113,9 +147,9 @@ func init() {
 	if apiDebug, ok := os.LookupEnv("LINODE_DEBUG"); ok {
 		if parsed, err := strconv.ParseBool(apiDebug); err == nil {
 			envDebug = parsed
-			log.Println("[INFO] LINODE_DEBUG being set to", envDebug)
+			envLogger.Debug("LINODE_DEBUG being set", "value", envDebug)
 		} else {
-			log.Println("[WARN] LINODE_DEBUG should be an integer, 0 or 1")
+			envLogger.Warn("LINODE_DEBUG should be an integer, 0 or 1", "value", apiDebug)
 		}
 	}
 }
@@ -124,6 +158,7 @@ func init() {
 func (c *Client) SetUserAgent(ua string) *Client {
 	c.userAgent = ua
 	c.resty.SetHeader("User-Agent", c.userAgent)
+	c.http.userAgent = c.userAgent
 
 	return c
 }
@@ -135,96 +170,240 @@ type RequestParams struct {
 
 // Generic helper to execute HTTP requests using the net/http package
 //
-// nolint:unused, funlen, gocognit
-func (c *httpClient) doRequest(ctx context.Context, method, url string, params RequestParams) error {
+// nolint:funlen, gocognit
+func (c *httpClient) doRequest(
+	ctx context.Context,
+	method, url string,
+	params RequestParams,
+	mutators ...func(*http.Request) error,
+) (err error) {
 	var (
 		req        *http.Request
 		bodyBuffer *bytes.Buffer
 		resp       *http.Response
-		err        error
+		respBody   []byte
+		attempts   int
+		firstStart = time.Now()
 	)
 
-	for range httpDefaultRetryCount {
+	if rt := requestTelemetryFromContext(ctx); rt != nil {
+		defer func() {
+			var apiError *APIError
+			if resp != nil && resp.StatusCode >= http.StatusBadRequest {
+				apiError = &APIError{}
+				if json.Unmarshal(respBody, apiError) != nil {
+					apiError = nil
+				}
+			}
+
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+
+			rt.end(status, attempts, apiError, err)
+		}()
+	}
+
+	for attempt := 0; ; attempt++ {
+		attempts = attempt + 1
+
 		req, bodyBuffer, err = c.createRequest(ctx, method, url, params)
 		if err != nil {
 			return err
 		}
 
-		if err = c.applyBeforeRequest(req); err != nil {
+		if err = c.applyBeforeRequest(req, mutators...); err != nil {
 			return err
 		}
 
+		c.applyClientHeaders(req)
+
 		if c.debug && c.logger != nil {
 			c.logRequest(req, method, url, bodyBuffer)
 		}
 
-		processResponse := func() error {
-			defer func() {
-				closeErr := resp.Body.Close()
-				if closeErr != nil && err == nil {
-					err = closeErr
-				}
-			}()
-			if err = c.checkHTTPError(resp); err != nil {
-				return err
-			}
-			if c.debug && c.logger != nil {
-				var logErr error
-				resp, logErr = c.logResponse(resp)
-				if logErr != nil {
-					return logErr
-				}
-			}
-			if params.Response != nil {
-				if err = c.decodeResponseBody(resp, params.Response); err != nil {
-					return err
-				}
-			}
-
-			// Apply after-response mutations
-			if err = c.applyAfterResponse(resp); err != nil {
-				return err
-			}
-
-			return nil
-		}
+		start := time.Now()
 
 		resp, err = c.sendRequest(req)
 		if err == nil {
-			if err = processResponse(); err == nil {
-				return nil
-			}
+			c.logResponseCompleted(req, resp, attempts, time.Since(start))
+			requestMetadataFromContext(ctx).record(resp, attempts)
+
+			respBody, err = c.processResponse(resp, params)
 		}
 
-		if !c.shouldRetry(resp, err) {
+		if !c.shouldRetry(req, resp, respBody, err, attempts, firstStart) {
 			break
 		}
 
-		retryAfter, retryErr := c.retryAfter(resp)
-		if retryErr != nil {
-			return retryErr
+		if waitErr := c.waitForRetry(ctx, resp, respBody, attempts); waitErr != nil {
+			return NewError(waitErr)
 		}
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	logRequestFailed(c.slogger(), method, req.URL.Path, err)
 
-		// Sleep for the specified duration before retrying.
-		// If retryAfter is 0 (i.e., Retry-After header is not found),
-		// no delay is applied.
-		time.Sleep(retryAfter)
+	if resp == nil {
+		// The request never received a response, so the error
+		// must be coupled here
+		return NewError(err)
 	}
 
 	return err
 }
 
-// nolint:unused
-func (c *httpClient) shouldRetry(resp *http.Response, err error) bool {
-	for _, retryConditional := range c.retryConditionals {
-		if retryConditional(resp, err) {
-			return true
+// processResponse buffers the body of the given response before checking it
+// for API errors and decoding it into the expected response type.
+func (c *httpClient) processResponse(resp *http.Response, params RequestParams) ([]byte, error) {
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.debug && c.logger != nil {
+		c.logResponse(resp)
+		resetResponseBody(resp, body)
+	}
+
+	// Apply after-response mutations, including for error responses
+	// to match the behavior of resty's OnAfterResponse
+	if err := c.applyAfterResponse(resp); err != nil {
+		return body, err
+	}
+
+	resetResponseBody(resp, body)
+
+	if err := c.checkHTTPError(resp); err != nil {
+		return body, err
+	}
+
+	resetResponseBody(resp, body)
+
+	// Some endpoints (e.g. DELETE requests) may not return a body
+	if params.Response != nil && len(bytes.TrimSpace(body)) > 0 {
+		if err := c.decodeResponseBody(resp, params.Response); err != nil {
+			return body, err
 		}
 	}
-	return false
+
+	return body, nil
+}
+
+func (c *httpClient) shouldRetry(
+	req *http.Request,
+	resp *http.Response,
+	respBody []byte,
+	err error,
+	attempt int,
+	start time.Time,
+) bool {
+	var header http.Header
+
+	status, retryAfter := 0, ""
+	if resp != nil {
+		status, header, retryAfter = resp.StatusCode, resp.Header, resp.Header.Get(retryAfterHeaderName)
+	}
+
+	retry := false
+
+	if maintenanceError := c.maintenance.retryError(status, header); maintenanceError != nil {
+		retry, err = true, maintenanceError
+	} else {
+		for _, retryConditional := range c.retryConditionals {
+			if resp != nil {
+				// Retry conditionals may consume the response body
+				resetResponseBody(resp, respBody)
+			}
+
+			if retryConditional(resp, err) {
+				retry = true
+				break
+			}
+		}
+	}
+
+	if !retry {
+		if resp != nil && isMaintenanceResponse(resp.StatusCode, resp.Header) {
+			logMaintenance(c.slogger(), req.Method, req.URL.Path)
+		}
+
+		return false
+	}
+
+	// Requests are never retried without a retry policy
+	if c.retryState == nil {
+		return false
+	}
+
+	var apiError *APIError
+	if resp != nil {
+		resetResponseBody(resp, respBody)
+		apiError, _ = getAPIError(resp)
+	}
+
+	if allowed, reason := c.retryState.forRequest(req.Context()).allowRetry(
+		req.Method,
+		requestEndpoint(c.baseURL, req.URL.String()),
+		attempt,
+		start,
+		status,
+		apiError,
+		err,
+	); !allowed {
+		logRetryDenied(c.slogger(), req.Method, req.URL.Path, status, attempt, reason)
+		return false
+	}
+
+	logRetry(c.slogger(), req.Method, req.URL.Path, status, attempt, retryReason(status, nil, err), retryAfter)
+
+	return true
+}
+
+// logResponseCompleted logs the completion of a single request attempt.
+func (c *httpClient) logResponseCompleted(req *http.Request, resp *http.Response, attempt int, duration time.Duration) {
+	logResponseCompleted(c.slogger(), req.Method, req.URL.Path, resp.StatusCode, attempt, duration)
+}
+
+func (c *httpClient) slogger() *slog.Logger {
+	if c.logState == nil {
+		return slog.New(discardHandler{})
+	}
+
+	return c.logState.get()
+}
+
+// waitForRetry blocks until the request can be retried or the context is cancelled.
+// The Retry-After response header takes precedence over the delay computed by the retry policy.
+func (c *httpClient) waitForRetry(ctx context.Context, resp *http.Response, respBody []byte, attempt int) error {
+	var retryAfter time.Duration
+
+	if c.retryAfter != nil && resp != nil {
+		resetResponseBody(resp, respBody)
+
+		var err error
+		if retryAfter, err = c.retryAfter(resp); err != nil {
+			return err
+		}
+	}
+
+	wait := c.retryState.forRequest(ctx).delay(attempt, retryAfter)
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-// nolint:unused
 func (c *httpClient) createRequest(ctx context.Context, method, url string, params RequestParams) (*http.Request, *bytes.Buffer, error) {
 	var bodyReader io.Reader
 	var bodyBuffer *bytes.Buffer
@@ -257,9 +436,8 @@ func (c *httpClient) createRequest(ctx context.Context, method, url string, para
 	return req, bodyBuffer, nil
 }
 
-// nolint:unused
-func (c *httpClient) applyBeforeRequest(req *http.Request) error {
-	for _, mutate := range c.onBeforeRequest {
+func (c *httpClient) applyBeforeRequest(req *http.Request, mutators ...func(*http.Request) error) error {
+	for _, mutate := range append(c.onBeforeRequest, mutators...) {
 		if err := mutate(req); err != nil {
 			if c.debug && c.logger != nil {
 				c.logger.Errorf("failed to mutate before request: %v", err)
@@ -270,7 +448,18 @@ func (c *httpClient) applyBeforeRequest(req *http.Request) error {
 	return nil
 }
 
-// nolint:unused
+// applyClientHeaders applies the client-level headers to the given request.
+// Headers that have already been set on the request take precedence.
+func (c *httpClient) applyClientHeaders(req *http.Request) {
+	for k, v := range c.header {
+		if req.Header.Get(k) != "" {
+			continue
+		}
+
+		req.Header[k] = append([]string(nil), v...)
+	}
+}
+
 func (c *httpClient) applyAfterResponse(resp *http.Response) error {
 	for _, mutate := range c.onAfterResponse {
 		if err := mutate(resp); err != nil {
@@ -283,7 +472,6 @@ func (c *httpClient) applyAfterResponse(resp *http.Response) error {
 	return nil
 }
 
-// nolint:unused
 func (c *httpClient) logRequest(req *http.Request, method, url string, bodyBuffer *bytes.Buffer) {
 	var reqBody string
 	if bodyBuffer != nil {
@@ -292,11 +480,17 @@ func (c *httpClient) logRequest(req *http.Request, method, url string, bodyBuffe
 		reqBody = "nil"
 	}
 
+	// masking authorization header
+	headers := req.Header.Clone()
+	if headers.Get("Authorization") != "" {
+		headers.Set("Authorization", "Bearer *******************************")
+	}
+
 	var logBuf bytes.Buffer
 	err := reqLogTemplate.Execute(&logBuf, map[string]interface{}{
 		"Method":  method,
 		"URL":     url,
-		"Headers": req.Header,
+		"Headers": headers,
 		"Body":    reqBody,
 	})
 	if err == nil {
@@ -304,7 +498,6 @@ func (c *httpClient) logRequest(req *http.Request, method, url string, bodyBuffe
 	}
 }
 
-// nolint:unused
 func (c *httpClient) sendRequest(req *http.Request) (*http.Response, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -313,10 +506,15 @@ func (c *httpClient) sendRequest(req *http.Request) (*http.Response, error) {
 		}
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
+
+	// Not all transports populate the originating request
+	if resp.Request == nil {
+		resp.Request = req
+	}
+
 	return resp, nil
 }
 
-// nolint:unused
 func (c *httpClient) checkHTTPError(resp *http.Response) error {
 	_, err := coupleAPIErrorsHTTP(resp, nil)
 	if err != nil {
@@ -328,8 +526,7 @@ func (c *httpClient) checkHTTPError(resp *http.Response) error {
 	return nil
 }
 
-// nolint:unused
-func (c *httpClient) logResponse(resp *http.Response) (*http.Response, error) {
+func (c *httpClient) logResponse(resp *http.Response) {
 	var respBody bytes.Buffer
 	if _, err := io.Copy(&respBody, resp.Body); err != nil {
 		c.logger.Errorf("failed to read response body: %v", err)
@@ -344,12 +541,8 @@ func (c *httpClient) logResponse(resp *http.Response) (*http.Response, error) {
 	if err == nil {
 		c.logger.Debugf(logBuf.String())
 	}
-
-	resp.Body = io.NopCloser(bytes.NewReader(respBody.Bytes()))
-	return resp, nil
 }
 
-// nolint:unused
 func (c *httpClient) decodeResponseBody(resp *http.Response, response interface{}) error {
 	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
 		if c.debug && c.logger != nil {
@@ -360,6 +553,27 @@ func (c *httpClient) decodeResponseBody(resp *http.Response, response interface{
 	return nil
 }
 
+// readResponseBody reads and closes the body of the given response,
+// replacing it with an in-memory copy that can be consumed again.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	resetResponseBody(resp, body)
+
+	return body, nil
+}
+
+// resetResponseBody replaces the body of the given response
+// with a fresh reader over the given buffered body.
+func resetResponseBody(resp *http.Response, body []byte) {
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+}
+
 // R wraps resty's R method
 func (c *Client) R(ctx context.Context) *resty.Request {
 	return c.resty.R().
@@ -373,6 +587,7 @@ func (c *Client) R(ctx context.Context) *resty.Request {
 func (c *Client) SetDebug(debug bool) *Client {
 	c.debug = debug
 	c.resty.SetDebug(debug)
+	c.http.httpSetDebug(debug)
 
 	return c
 }
@@ -380,19 +595,23 @@ func (c *Client) SetDebug(debug bool) *Client {
 // SetLogger allows the user to override the output
 // logger for debug logs.
 func (c *Client) SetLogger(logger Logger) *Client {
-	c.resty.SetLogger(logger)
+	c.hooks.logger = logger
+	c.setLogger(logger)
 
 	return c
 }
 
-//nolint:unused
+func (c *Client) setLogger(logger Logger) {
+	c.resty.SetLogger(logger)
+	c.http.httpSetLogger(logger)
+}
+
 func (c *httpClient) httpSetDebug(debug bool) *httpClient {
 	c.debug = debug
 
 	return c
 }
 
-//nolint:unused
 func (c *httpClient) httpSetLogger(logger httpLogger) *httpClient {
 	c.logger = logger
 
@@ -401,6 +620,7 @@ func (c *httpClient) httpSetLogger(logger httpLogger) *httpClient {
 
 // OnBeforeRequest adds a handler to the request body to run before the request is sent
 func (c *Client) OnBeforeRequest(m func(request *Request) error) {
+	c.hooks.beforeRequest = append(c.hooks.beforeRequest, m)
 	c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
 		return m(req)
 	})
@@ -408,25 +628,65 @@ func (c *Client) OnBeforeRequest(m func(request *Request) error) {
 
 // OnAfterResponse adds a handler to the request body to run before the request is sent
 func (c *Client) OnAfterResponse(m func(response *Response) error) {
+	c.hooks.afterResponse = append(c.hooks.afterResponse, m)
 	c.resty.OnAfterResponse(func(_ *resty.Client, req *resty.Response) error {
 		return m(req)
 	})
 }
 
-// nolint:unused
+// OnBeforeHTTPRequest adds a handler to run before a request is sent
+// through the net/http execution path configured by UseHTTPTransport.
+func (c *Client) OnBeforeHTTPRequest(m func(request *http.Request) error) {
+	c.hooks.beforeHTTPRequest = append(c.hooks.beforeHTTPRequest, m)
+	c.http.httpOnBeforeRequest(m)
+}
+
+// OnAfterHTTPResponse adds a handler to run after a response is received
+// through the net/http execution path configured by UseHTTPTransport.
+func (c *Client) OnAfterHTTPResponse(m func(response *http.Response) error) {
+	c.hooks.afterHTTPResponse = append(c.hooks.afterHTTPResponse, m)
+	c.http.httpOnAfterResponse(m)
+}
+
 func (c *httpClient) httpOnBeforeRequest(m func(*http.Request) error) *httpClient {
 	c.onBeforeRequest = append(c.onBeforeRequest, m)
 
 	return c
 }
 
-// nolint:unused
 func (c *httpClient) httpOnAfterResponse(m func(*http.Response) error) *httpClient {
 	c.onAfterResponse = append(c.onAfterResponse, m)
 
 	return c
 }
 
+// UseHTTPTransport configures the client to execute requests made by its API methods
+// using net/http and the given http.RoundTripper rather than resty.
+// If transport is nil, the http.Client provided to NewClient will be used.
+//
+// Retries, caching and debug logging behave the same as they do with resty,
+// however handlers registered with OnBeforeRequest and OnAfterResponse will not be
+// run; use OnBeforeHTTPRequest and OnAfterHTTPResponse instead.
+// Requests created directly using R(...) will continue to use resty.
+func (c *Client) UseHTTPTransport(transport http.RoundTripper) *Client {
+	if transport != nil {
+		c.http.httpClient = &http.Client{
+			Transport: transport,
+			Timeout:   c.resty.GetClient().Timeout,
+		}
+	}
+
+	c.useHTTP = true
+
+	return c
+}
+
+// hostURL returns the full URL to the given endpoint,
+// applying the API version of the given request options.
+func (c *Client) hostURL(opts *RequestOptions, endpoint string) string {
+	return strings.TrimSuffix(opts.baseURL(c.resty.BaseURL), "/") + "/" + strings.TrimPrefix(endpoint, "/")
+}
+
 // UseURL parses the individual components of the given API URL and configures the client
 // accordingly. For example, a valid URL.
 // For example:
@@ -504,6 +764,8 @@ func (c *Client) updateHostURL() {
 			url.PathEscape(apiVersion),
 		),
 	)
+
+	c.http.baseURL = c.resty.BaseURL
 }
 
 // SetRootCertificate adds a root certificate to the underlying TLS client config
@@ -514,11 +776,20 @@ func (c *Client) SetRootCertificate(path string) *Client {
 
 // SetToken sets the API token for all requests from this client
 // Only necessary if you haven't already provided the http client to NewClient() configured with the token.
+// Any TokenSource set using SetTokenSource is removed.
 func (c *Client) SetToken(token string) *Client {
-	c.resty.SetHeader("Authorization", fmt.Sprintf("Bearer %s", token))
+	c.SetTokenSource(nil)
+	c.setToken(token)
 	return c
 }
 
+// setToken sets the API token for all requests from this client
+// without removing any TokenSource, which still takes precedence.
+func (c *Client) setToken(token string) {
+	c.resty.SetHeader("Authorization", fmt.Sprintf("Bearer %s", token))
+	c.http.header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+}
+
 // SetRetries adds retry conditions for "Linode Busy." errors and 429s.
 func (c *Client) SetRetries() *Client {
 	c.
@@ -530,11 +801,13 @@ func (c *Client) SetRetries() *Client {
 		addRetryConditional(requestNGINXRetryCondition).
 		SetRetryMaxWaitTime(APIRetryMaxWaitTime)
 	configureRetries(c)
+	httpConfigureRetries(c.http)
 	return c
 }
 
 // AddRetryCondition adds a RetryConditional function to the Client
 func (c *Client) AddRetryCondition(retryCondition RetryConditional) *Client {
+	c.hooks.retryConditions = append(c.hooks.retryConditions, retryCondition)
 	c.resty.AddRetryCondition(resty.RetryConditionFunc(retryCondition))
 	return c
 }
@@ -545,13 +818,13 @@ func (c *Client) addRetryConditional(retryConditional RetryConditional) *Client
 }
 
 func (c *Client) addCachedResponse(endpoint string, response any, expiry *time.Duration) {
-	if !c.shouldCache {
+	if !c.shouldCache || c.cache == nil {
 		return
 	}
 
 	responseValue := reflect.ValueOf(response)
 
-	entry := clientCacheEntry{
+	entry := CacheEntry{
 		Created:        time.Now(),
 		ExpiryOverride: expiry,
 	}
@@ -565,81 +838,81 @@ func (c *Client) addCachedResponse(endpoint string, response any, expiry *time.D
 		entry.Data = response
 	}
 
-	c.cachedEntryLock.Lock()
-	defer c.cachedEntryLock.Unlock()
-
-	c.cachedEntries[endpoint] = entry
+	c.cache.Set(endpoint, entry)
 }
 
-func (c *Client) getCachedResponse(endpoint string) any {
-	if !c.shouldCache {
-		return nil
+// InvalidateCache clears all cached responses for all endpoints.
+func (c *Client) InvalidateCache() {
+	if c.cache != nil {
+		c.cache.Clear()
 	}
+}
 
-	c.cachedEntryLock.RLock()
-
-	// Hacky logic to dynamically RUnlock
-	// only if it is still locked by the
-	// end of the function.
-	// This is necessary as we take write
-	// access if the entry has expired.
-	rLocked := true
-	defer func() {
-		if rLocked {
-			c.cachedEntryLock.RUnlock()
-		}
-	}()
+// InvalidateCacheEndpoint invalidates a single cached endpoint.
+func (c *Client) InvalidateCacheEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to parse URL for caching: %w", err)
+	}
 
-	entry, ok := c.cachedEntries[endpoint]
-	if !ok {
+	if c.cache == nil {
 		return nil
 	}
 
-	// Handle expired entries
-	elapsedTime := time.Since(entry.Created)
-
-	hasExpired := elapsedTime > c.cacheExpiration
-	if entry.ExpiryOverride != nil {
-		hasExpired = elapsedTime > *entry.ExpiryOverride
+	// Delete the endpoint from every scope, as in invalidateCachePaths
+	for _, key := range c.cache.Keys() {
+		if strings.Trim(cacheKeyEndpoint(key), "/") == strings.Trim(u.Path, "/") {
+			c.cache.Delete(key)
+		}
 	}
 
-	if hasExpired {
-		// We need to give up our read access and request read-write access
-		c.cachedEntryLock.RUnlock()
-		rLocked = false
-
-		c.cachedEntryLock.Lock()
-		defer c.cachedEntryLock.Unlock()
-
-		delete(c.cachedEntries, endpoint)
-		return nil
-	}
+	return nil
+}
 
-	return c.cachedEntries[endpoint].Data
+// SetCache sets the backend used to store cached responses.
+// By default, an in-memory LRUCache bounded to APIDefaultCacheMaxEntries
+// entries is used.
+//
+// Cached responses are keyed by the API URL and credentials of the client,
+// so a backend can safely be shared between clients using different accounts.
+func (c *Client) SetCache(cache Cache) {
+	c.cache = cache
 }
 
-// InvalidateCache clears all cached responses for all endpoints.
-func (c *Client) InvalidateCache() {
-	c.cachedEntryLock.Lock()
-	defer c.cachedEntryLock.Unlock()
+// AddCacheDependencies declares additional endpoints to invalidate in the cache
+// when a mutating request is made to a matching endpoint. For example:
+//
+//	client.AddCacheDependencies(linodego.CacheDependency{
+//		Method:      http.MethodPost,
+//		Endpoint:    "linode/instances",
+//		Invalidates: []string{"networking/ips", "tags"},
+//	})
+func (c *Client) AddCacheDependencies(dependencies ...CacheDependency) {
+	c.cacheDependencies = append(c.cacheDependencies, dependencies...)
+}
 
-	// GC will handle the old map
-	c.cachedEntries = make(map[string]clientCacheEntry)
+// SetCacheStaleWhileRevalidate sets the duration after a cached response expires
+// during which the stale response will still be returned while it is refreshed in
+// the background. A window of 0 (the default) disables this behavior.
+func (c *Client) SetCacheStaleWhileRevalidate(window time.Duration) {
+	c.cacheStaleWindow = window
 }
 
-// InvalidateCacheEndpoint invalidates a single cached endpoint.
-func (c *Client) InvalidateCacheEndpoint(endpoint string) error {
-	u, err := url.Parse(endpoint)
-	if err != nil {
-		return fmt.Errorf("failed to parse URL for caching: %w", err)
+// CacheStats returns the hit, miss and eviction counters for the client's response cache.
+func (c *Client) CacheStats() CacheStats {
+	stats := CacheStats{
+		Hits:        c.cacheState.hits.Load(),
+		Misses:      c.cacheState.misses.Load(),
+		StaleHits:   c.cacheState.staleHits.Load(),
+		Expirations: c.cacheState.expirations.Load(),
 	}
 
-	c.cachedEntryLock.Lock()
-	defer c.cachedEntryLock.Unlock()
-
-	delete(c.cachedEntries, u.Path)
+	if c.cache != nil {
+		stats.Evictions = c.cache.Evictions()
+		stats.Entries = c.cache.Len()
+	}
 
-	return nil
+	return stats
 }
 
 // SetGlobalCacheExpiration sets the desired time for any cached response
@@ -654,27 +927,43 @@ func (c *Client) UseCache(value bool) {
 }
 
 // SetRetryMaxWaitTime sets the maximum delay before retrying a request.
+// This is equivalent to setting the MaxInterval of the client's RetryPolicy.
 func (c *Client) SetRetryMaxWaitTime(maxWaitTime time.Duration) *Client {
-	c.resty.SetRetryMaxWaitTime(maxWaitTime)
+	c.retryState.update(func(p *RetryPolicy) {
+		p.MaxInterval = maxWaitTime
+	})
+	c.applyRetryPolicy()
+
 	return c
 }
 
 // SetRetryWaitTime sets the default (minimum) delay before retrying a request.
+// This is equivalent to setting the InitialInterval of the client's RetryPolicy.
 func (c *Client) SetRetryWaitTime(minWaitTime time.Duration) *Client {
-	c.resty.SetRetryWaitTime(minWaitTime)
+	c.retryState.update(func(p *RetryPolicy) {
+		p.InitialInterval = minWaitTime
+	})
+	c.applyRetryPolicy()
+
 	return c
 }
 
 // SetRetryAfter sets the callback function to be invoked with a failed request
 // to determine wben it should be retried.
 func (c *Client) SetRetryAfter(callback RetryAfter) *Client {
+	c.hooks.retryAfter = callback
 	c.resty.SetRetryAfter(resty.RetryAfterFunc(callback))
 	return c
 }
 
 // SetRetryCount sets the maximum retry attempts before aborting.
+// This is equivalent to setting the MaxAttempts of the client's RetryPolicy to count + 1.
 func (c *Client) SetRetryCount(count int) *Client {
-	c.resty.SetRetryCount(count)
+	c.retryState.update(func(p *RetryPolicy) {
+		p.MaxAttempts = max(count, 0) + 1
+	})
+	c.applyRetryPolicy()
+
 	return c
 }
 
@@ -691,11 +980,20 @@ func (c *Client) GetPollDelay() time.Duration {
 	return c.pollInterval
 }
 
+// SetPageConcurrency sets the maximum number of pages requested in parallel by list
+// endpoint functions once the first page has been received. Pages are requested one
+// after another by default. This can be overridden using ListOptions.Concurrency.
+func (c *Client) SetPageConcurrency(concurrency int) *Client {
+	c.pageConcurrency = concurrency
+	return c
+}
+
 // SetHeader sets a custom header to be used in all API requests made with the current
 // client.
 // NOTE: Some headers may be overridden by the individual request functions.
 func (c *Client) SetHeader(name, value string) {
 	c.resty.SetHeader(name, value)
+	c.http.header.Set(name, value)
 }
 
 func (c *Client) enableLogSanitization() *Client {
@@ -710,18 +1008,7 @@ func (c *Client) enableLogSanitization() *Client {
 
 // NewClient factory to create new Client struct
 func NewClient(hc *http.Client) (client Client) {
-	if hc != nil {
-		client.resty = resty.NewWithClient(hc)
-	} else {
-		client.resty = resty.New()
-	}
-
-	client.shouldCache = true
-	client.cacheExpiration = APIDefaultCacheExpiration
-	client.cachedEntries = make(map[string]clientCacheEntry)
-	client.cachedEntryLock = &sync.RWMutex{}
-
-	client.SetUserAgent(DefaultUserAgent)
+	client = newClient(hc)
 
 	baseURL, baseURLExists := os.LookupEnv(APIHostVar)
 
@@ -735,26 +1022,74 @@ func NewClient(hc *http.Client) (client Client) {
 		client.SetAPIVersion(APIVersion)
 	}
 
-	certPath, certPathExists := os.LookupEnv(APIHostCert)
-
-	if certPathExists {
-		cert, err := os.ReadFile(filepath.Clean(certPath))
-		if err != nil {
-			log.Fatalf("[ERROR] Error when reading cert at %s: %s\n", certPath, err.Error())
+	if certPath, certPathExists := os.LookupEnv(APIHostCert); certPathExists {
+		// Errors are returned by NewClientFromEnv since NewClient cannot return them
+		if err := client.SetClientOptions(ClientOptions{CACertPath: certPath}); err != nil {
+			client.envErr = fmt.Errorf("failed to use API root certificate %s: %w", certPath, err)
+			client.logState.get().Error("Failed to set API root certificate", "path", certPath, "error", err)
+		} else {
+			client.logState.get().Debug("Set API root certificate", "path", certPath)
 		}
+	}
 
-		client.SetRootCertificate(certPath)
+	client.SetDebug(envDebug)
 
-		if envDebug {
-			log.Printf("[DEBUG] Set API root certificate to %s with contents %s\n", certPath, cert)
-		}
+	return
+}
+
+// newClient creates a Client with the default configuration,
+// ignoring any environment variables.
+func newClient(hc *http.Client) (client Client) {
+	if hc != nil {
+		client.resty = resty.NewWithClient(hc)
+	} else {
+		client.resty = resty.New()
 	}
 
+	// Share the underlying http.Client (and its connection pool)
+	// between resty and the net/http execution path
+	client.http = newHTTPClient(client.resty.GetClient())
+
+	client.shouldCache = true
+	client.cacheExpiration = APIDefaultCacheExpiration
+	client.cache = NewLRUCache(APIDefaultCacheMaxEntries)
+	client.cacheState = &clientCacheState{}
+	client.cacheDependencies = slices.Clone(defaultCacheDependencies)
+
+	client.logState = newClientLogState()
+	client.http.logState = client.logState
+	client.setLogger(newClientLogger(client.logState))
+	client.configureLogging()
+
+	// Telemetry must be configured before rate limiting so that
+	// time spent waiting for the rate limiter is included in spans
+	client.telemetry = newClientTelemetry()
+	client.configureTelemetry()
+
+	client.maintenance = &maintenanceState{}
+	client.http.maintenance = client.maintenance
+	client.configureMaintenance()
+
+	client.rateLimit = &rateLimitState{}
+	client.configureRateLimiting()
+
+	client.retryState = newClientRetryState()
+	client.http.retryState = client.retryState
+	client.configureRetryPolicy()
+
+	client.tokenSource = &tokenSourceState{}
+	client.configureTokenSource()
+
+	client.dryRun = &dryRunState{}
+
+	// Request options are applied last so that their headers take precedence
+	client.configureRequestOptions()
+
+	client.SetUserAgent(DefaultUserAgent)
+
 	client.
-		SetRetryWaitTime(APISecondsPerPoll * time.Second).
 		SetPollDelay(APISecondsPerPoll * time.Second).
 		SetRetries().
-		SetDebug(envDebug).
 		enableLogSanitization()
 
 	return
@@ -764,6 +1099,9 @@ func NewClient(hc *http.Client) (client Client) {
 // from the LINODE_CONFIG file and the LINODE_TOKEN environment variable.
 func NewClientFromEnv(hc *http.Client) (*Client, error) {
 	client := NewClient(hc)
+	if client.envErr != nil {
+		return nil, client.envErr
+	}
 
 	// Users are expected to chain NewClient(...) and LoadConfig(...) to customize these options
 	configPath, err := resolveValidConfigPath()
@@ -797,14 +1135,23 @@ func NewClientFromEnv(hc *http.Client) (*Client, error) {
 		return nil, fmt.Errorf("error loading config file %s: %w", configPath, err)
 	}
 
-	err = client.preLoadConfig(configPath)
-	return &client, err
+	if err := client.preLoadConfig(configPath); err != nil {
+		return &client, err
+	}
+
+	// The profile is loaded lazily, so its transport settings are validated here
+	// to surface invalid settings before the first request
+	if profile, ok := client.configProfiles[strings.ToLower(configProfile)]; ok {
+		if _, err := profile.clientOptions().load(); err != nil {
+			return nil, fmt.Errorf("invalid settings for profile %s: %w", configProfile, err)
+		}
+	}
+
+	return &client, nil
 }
 
 func (c *Client) preLoadConfig(configPath string) error {
-	if envDebug {
-		log.Printf("[INFO] Loading profile from %s\n", configPath)
-	}
+	c.logState.get().Debug("Loading profile", "path", configPath)
 
 	if err := c.LoadConfig(&LoadConfigOptions{
 		Path:            configPath,
@@ -814,19 +1161,26 @@ func (c *Client) preLoadConfig(configPath string) error {
 	}
 
 	// We don't want to load the profile until the user is actually making requests
-	c.OnBeforeRequest(func(_ *Request) error {
-		if c.loadedProfile != c.selectedProfile {
-			if err := c.UseProfile(c.selectedProfile); err != nil {
-				return err
-			}
-		}
+	c.resty.OnBeforeRequest(func(_ *resty.Client, _ *resty.Request) error {
+		return c.loadSelectedProfile()
+	})
 
-		return nil
+	c.http.httpOnBeforeRequest(func(_ *http.Request) error {
+		return c.loadSelectedProfile()
 	})
 
 	return nil
 }
 
+// loadSelectedProfile loads the selected profile if it has not been loaded yet.
+func (c *Client) loadSelectedProfile() error {
+	if c.selectedProfile != "" && c.loadedProfile != c.selectedProfile {
+		return c.UseProfile(c.selectedProfile)
+	}
+
+	return nil
+}
+
 func copyBool(bPtr *bool) *bool {
 	if bPtr == nil {
 		return nil
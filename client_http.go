@@ -1,56 +1,33 @@
 package linodego
 
-import (
-	"net/http"
-	"sync"
-	"time"
-)
+import "net/http"
 
-// Client is a wrapper around the Resty client
-//
-//nolint:unused
+// httpClient executes API requests using the net/http package directly
+// rather than resty. It is used by a Client configured with UseHTTPTransport.
 type httpClient struct {
-	//nolint:unused
 	httpClient *http.Client
-	//nolint:unused
-	userAgent string
-	//nolint:unused
-	debug bool
-	//nolint:unused
-	retryConditionals []httpRetryConditional
-	//nolint:unused
-	retryAfter httpRetryAfter
-
-	//nolint:unused
-	pollInterval time.Duration
+	userAgent  string
+	header     http.Header
+	debug      bool
 
-	//nolint:unused
 	baseURL string
-	//nolint:unused
-	apiVersion string
-	//nolint:unused
-	apiProto string
-	//nolint:unused
-	selectedProfile string
-	//nolint:unused
-	loadedProfile string
 
-	//nolint:unused
-	configProfiles map[string]ConfigProfile
+	retryConditionals []httpRetryConditional
+	retryAfter        httpRetryAfter
+	retryState        *clientRetryState
+	maintenance       *maintenanceState
 
-	// Fields for caching endpoint responses
-	//nolint:unused
-	shouldCache bool
-	//nolint:unused
-	cacheExpiration time.Duration
-	//nolint:unused
-	cachedEntries map[string]clientCacheEntry
-	//nolint:unused
-	cachedEntryLock *sync.RWMutex
-	//nolint:unused
-	logger httpLogger
-	//nolint:unused
+	logger          httpLogger
+	logState        *clientLogState
 	onBeforeRequest []func(*http.Request) error
-	//nolint:unused
 	onAfterResponse []func(*http.Response) error
 }
+
+// newHTTPClient creates a new httpClient using the given http.Client.
+func newHTTPClient(hc *http.Client) *httpClient {
+	return &httpClient{
+		httpClient: hc,
+		header:     make(http.Header),
+		logger:     createLogger(),
+	}
+}
@@ -0,0 +1,241 @@
+package linodego
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// ClientOptions configures the transport used by a Client.
+// Empty fields leave the corresponding settings of the client unchanged.
+type ClientOptions struct {
+	// CACertPath is the path of a PEM encoded CA bundle used to verify the API's certificate
+	// instead of the system's root CAs.
+	CACertPath string
+
+	// ClientCertPath and ClientKeyPath are the paths of a PEM encoded client
+	// certificate and key presented to the API.
+	ClientCertPath string
+	ClientKeyPath  string
+
+	// ProxyURL is the URL of the proxy requests are sent through.
+	ProxyURL string
+
+	// Timeout is the time limit of each request attempt.
+	Timeout time.Duration
+
+	// Headers are additional headers sent with every request.
+	Headers map[string]string
+}
+
+// clientTransportSettings are the loaded transport settings of a ClientOptions.
+type clientTransportSettings struct {
+	rootCAs      *x509.CertPool
+	certificates []tls.Certificate
+	proxy        *url.URL
+}
+
+func (s *clientTransportSettings) empty() bool {
+	return s.rootCAs == nil && len(s.certificates) == 0 && s.proxy == nil
+}
+
+// load reads and validates the transport settings of the options.
+func (o ClientOptions) load() (*clientTransportSettings, error) {
+	result := &clientTransportSettings{}
+
+	if o.CACertPath != "" {
+		pem, err := os.ReadFile(filepath.Clean(o.CACertPath))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		result.rootCAs = x509.NewCertPool()
+		if !result.rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates found in CA bundle %s", o.CACertPath)
+		}
+	}
+
+	if o.ClientCertPath != "" || o.ClientKeyPath != "" {
+		if o.ClientCertPath == "" || o.ClientKeyPath == "" {
+			return nil, errors.New("both a client certificate and key must be provided")
+		}
+
+		cert, err := tls.LoadX509KeyPair(filepath.Clean(o.ClientCertPath), filepath.Clean(o.ClientKeyPath))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		result.certificates = []tls.Certificate{cert}
+	}
+
+	if o.ProxyURL != "" {
+		proxy, err := url.Parse(o.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %s: a scheme and host are required", o.ProxyURL)
+		}
+
+		result.proxy = proxy
+	}
+
+	if o.Timeout < 0 {
+		return nil, fmt.Errorf("invalid timeout: %s", o.Timeout)
+	}
+
+	return result, nil
+}
+
+// SetClientOptions applies the given options to the client's transport.
+// TLS and proxy settings require the client to use an *http.Transport,
+// which is the case unless a custom http.Client was passed to NewClient.
+// The transport is cloned before it is modified, so other clients sharing
+// it (e.g. clients derived using With) are unaffected.
+func (c *Client) SetClientOptions(opts ClientOptions) error {
+	settings, err := opts.load()
+	if err != nil {
+		return err
+	}
+
+	if !settings.empty() {
+		transport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+
+		transport = transport.Clone()
+		settings.apply(transport)
+		c.resty.GetClient().Transport = transport
+	}
+
+	if opts.Timeout > 0 {
+		c.setTimeout(opts.Timeout)
+	}
+
+	for k, v := range opts.Headers {
+		c.SetHeader(k, v)
+	}
+
+	return nil
+}
+
+// setTimeout sets the timeout of each request attempt made using resty or net/http.
+func (c *Client) setTimeout(timeout time.Duration) {
+	c.resty.SetTimeout(timeout)
+
+	// The http.Client installed using UseHTTPTransport may be shared with other clients
+	if hc := c.http.httpClient; hc != c.resty.GetClient() && hc.Timeout != timeout {
+		copied := *hc
+		copied.Timeout = timeout
+		c.http.httpClient = &copied
+	}
+}
+
+// profileBaseline holds the settings of a client before the ClientOptions of a
+// profile were applied, which are restored before applying those of another profile.
+type profileBaseline struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+
+	// headers holds the previous values of the headers set by the
+	// current profile, which are nil for headers that were not set
+	headers map[string][]string
+}
+
+// useProfileClientOptions applies the given options of a profile to the
+// client's transport, replacing those of the previously used profile.
+func (c *Client) useProfileClientOptions(opts ClientOptions) error {
+	if c.profileBaseline == nil {
+		c.profileBaseline = &profileBaseline{
+			transport: c.resty.GetClient().Transport,
+			timeout:   c.resty.GetClient().Timeout,
+		}
+	} else {
+		c.restoreProfileBaseline()
+	}
+
+	headers := make(map[string][]string, len(opts.Headers))
+	for name := range opts.Headers {
+		headers[name] = slices.Clone(c.resty.Header.Values(name))
+	}
+
+	if err := c.SetClientOptions(opts); err != nil {
+		return err
+	}
+
+	c.profileBaseline.headers = headers
+
+	return nil
+}
+
+// restoreProfileBaseline restores the settings of the client
+// before the ClientOptions of a profile were applied.
+func (c *Client) restoreProfileBaseline() {
+	b := c.profileBaseline
+
+	c.resty.GetClient().Transport = b.transport
+	c.setTimeout(b.timeout)
+
+	for name, values := range b.headers {
+		name = http.CanonicalHeaderKey(name)
+
+		if values == nil {
+			c.resty.Header.Del(name)
+			c.http.header.Del(name)
+
+			continue
+		}
+
+		c.resty.Header[name] = slices.Clone(values)
+		c.http.header[name] = slices.Clone(values)
+	}
+
+	b.headers = nil
+}
+
+// httpTransport returns the *http.Transport used by the client.
+func (c *Client) httpTransport() (*http.Transport, error) {
+	current := c.resty.GetClient().Transport
+	if current == nil {
+		current = http.DefaultTransport
+	}
+
+	transport, ok := current.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unable to apply TLS or proxy settings to transport of type %T", current)
+	}
+
+	return transport, nil
+}
+
+func (s *clientTransportSettings) apply(transport *http.Transport) {
+	if s.rootCAs != nil || len(s.certificates) > 0 {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if transport.TLSClientConfig != nil {
+			tlsConfig = transport.TLSClientConfig.Clone()
+		}
+
+		if s.rootCAs != nil {
+			tlsConfig.RootCAs = s.rootCAs
+		}
+
+		if len(s.certificates) > 0 {
+			tlsConfig.Certificates = s.certificates
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if s.proxy != nil {
+		transport.Proxy = http.ProxyURL(s.proxy)
+	}
+}
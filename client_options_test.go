@@ -0,0 +1,107 @@
+package linodego
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCertificate writes a self-signed certificate and its key to the given directory.
+func writeTestCertificate(t *testing.T, dir string) (certPath, keyPath string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath = filepath.Join(dir, "cert.pem")
+	keyPath = filepath.Join(dir, "key.pem")
+
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certPath, keyPath
+}
+
+func TestClient_SetClientOptions(t *testing.T) {
+	certPath, keyPath := writeTestCertificate(t, t.TempDir())
+
+	client := NewClient(nil)
+
+	err := client.SetClientOptions(ClientOptions{
+		CACertPath:     certPath,
+		ClientCertPath: certPath,
+		ClientKeyPath:  keyPath,
+		ProxyURL:       "http://proxy.example.com:3128",
+		Timeout:        10 * time.Second,
+		Headers:        map[string]string{"X-Team": "infra"},
+	})
+	require.NoError(t, err)
+
+	transport, ok := client.resty.GetClient().Transport.(*http.Transport)
+	require.True(t, ok)
+	require.NotNil(t, transport.TLSClientConfig.RootCAs)
+	require.Len(t, transport.TLSClientConfig.Certificates, 1)
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.linode.com/v4/profile", nil)
+	require.NoError(t, err)
+
+	proxy, err := transport.Proxy(req)
+	require.NoError(t, err)
+	require.Equal(t, "proxy.example.com:3128", proxy.Host)
+
+	require.Equal(t, 10*time.Second, client.resty.GetClient().Timeout)
+	require.Equal(t, "infra", client.resty.Header.Get("X-Team"))
+}
+
+func TestClient_SetClientOptions_Invalid(t *testing.T) {
+	certPath, _ := writeTestCertificate(t, t.TempDir())
+
+	for name, opts := range map[string]ClientOptions{
+		"missing CA bundle": {CACertPath: filepath.Join(t.TempDir(), "missing.pem")},
+		"invalid CA bundle": {CACertPath: os.Args[0]},
+		"missing key":       {ClientCertPath: certPath},
+		"relative proxy":    {ProxyURL: "proxy.example.com"},
+		"negative timeout":  {Timeout: -time.Second},
+	} {
+		client := NewClient(nil)
+		require.Error(t, client.SetClientOptions(opts), name)
+	}
+
+	// TLS settings cannot be applied to custom transports
+	client := NewClient(&http.Client{Transport: httpmock.NewMockTransport()})
+	require.Error(t, client.SetClientOptions(ClientOptions{CACertPath: certPath}))
+}
+
+func TestNewClientFromEnv_InvalidCA(t *testing.T) {
+	t.Setenv(APIEnvVar, "mytoken")
+	t.Setenv(APIHostCert, filepath.Join(t.TempDir(), "missing.pem"))
+
+	_, err := NewClientFromEnv(nil)
+	require.ErrorContains(t, err, "failed to use API root certificate")
+}
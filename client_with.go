@@ -0,0 +1,388 @@
+package linodego
+
+import (
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Option configures a Client created using NewClientWithOptions or derived using Client.With.
+type Option interface {
+	apply(c *Client) error
+}
+
+type optionFunc func(c *Client) error
+
+func (f optionFunc) apply(c *Client) error {
+	return f(c)
+}
+
+// httpClientOption is handled separately since the http.Client
+// must be known before the Client is created.
+type httpClientOption struct {
+	hc *http.Client
+}
+
+func (o httpClientOption) apply(*Client) error {
+	return nil
+}
+
+// WithHTTPClient sets the http.Client used to make requests.
+// By default, NewClientWithOptions creates a new http.Client and clients
+// derived using With share the transport of their parent.
+func WithHTTPClient(hc *http.Client) Option {
+	return httpClientOption{hc: hc}
+}
+
+// WithToken sets the API token used to authenticate requests.
+func WithToken(token string) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetToken(token)
+		return nil
+	})
+}
+
+// WithTokenSource sets the TokenSource used to authenticate requests.
+func WithTokenSource(source TokenSource) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetTokenSource(source)
+		return nil
+	})
+}
+
+// WithBaseURL sets the base URL of the Linode API, e.g. https://api.linode.com.
+func WithBaseURL(baseURL string) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetBaseURL(baseURL)
+		return nil
+	})
+}
+
+// WithAPIVersion sets the version of the Linode API, e.g. v4beta.
+func WithAPIVersion(apiVersion string) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetAPIVersion(apiVersion)
+		return nil
+	})
+}
+
+// WithURL sets the base URL and version of the Linode API from a single URL,
+// e.g. https://api.linode.com/v4beta. See Client.UseURL.
+func WithURL(apiURL string) Option {
+	return optionFunc(func(c *Client) error {
+		_, err := c.UseURL(apiURL)
+		return err
+	})
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetUserAgent(userAgent)
+		return nil
+	})
+}
+
+// WithHeader sets a header sent with every request.
+func WithHeader(name, value string) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetHeader(name, value)
+		return nil
+	})
+}
+
+// WithClientOptions applies the given transport settings. See Client.SetClientOptions.
+func WithClientOptions(opts ClientOptions) Option {
+	return optionFunc(func(c *Client) error {
+		return c.SetClientOptions(opts)
+	})
+}
+
+// WithConfig loads profiles from a Linode config file. See Client.LoadConfig.
+func WithConfig(options *LoadConfigOptions) Option {
+	return optionFunc(func(c *Client) error {
+		return c.LoadConfig(options)
+	})
+}
+
+// WithProfile uses the given profile, which must have been loaded
+// using WithConfig or by the parent client. See Client.UseProfile.
+func WithProfile(name string) Option {
+	return optionFunc(func(c *Client) error {
+		return c.UseProfile(name)
+	})
+}
+
+// WithRetryPolicy sets the RetryPolicy used to retry failed requests.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetRetryPolicy(policy)
+		return nil
+	})
+}
+
+// WithRetryCount sets the maximum number of retries of a failed request.
+func WithRetryCount(count int) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetRetryCount(count)
+		return nil
+	})
+}
+
+// WithRateLimiter sets the RateLimiter used to limit requests.
+// Passing nil disables client-side rate limiting.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetRateLimiter(limiter)
+		return nil
+	})
+}
+
+// WithCache sets the backend used to store cached responses.
+func WithCache(cache Cache) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetCache(cache)
+		return nil
+	})
+}
+
+// WithCacheEnabled sets whether responses should be cached.
+func WithCacheEnabled(enabled bool) Option {
+	return optionFunc(func(c *Client) error {
+		c.UseCache(enabled)
+		return nil
+	})
+}
+
+// WithPollDelay sets the delay between polls made by WaitFor* functions.
+func WithPollDelay(delay time.Duration) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetPollDelay(delay)
+		return nil
+	})
+}
+
+// WithPageConcurrency sets the maximum number of pages requested in parallel
+// by list endpoint functions. See Client.SetPageConcurrency.
+func WithPageConcurrency(concurrency int) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetPageConcurrency(concurrency)
+		return nil
+	})
+}
+
+// WithDebug sets whether debug output is written.
+func WithDebug(debug bool) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetDebug(debug)
+		return nil
+	})
+}
+
+// WithSlogLogger sets the logger for structured log messages emitted by the client.
+func WithSlogLogger(logger *slog.Logger) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetSlogLogger(logger)
+		return nil
+	})
+}
+
+// WithMaintenancePause configures pausing requests during maintenance. See Client.SetMaintenancePause.
+func WithMaintenancePause(opts *MaintenancePauseOptions) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetMaintenancePause(opts)
+		return nil
+	})
+}
+
+// WithDryRun configures dry-run mode. See Client.SetDryRun.
+func WithDryRun(dryRun *DryRun) Option {
+	return optionFunc(func(c *Client) error {
+		c.SetDryRun(dryRun)
+		return nil
+	})
+}
+
+// NewClientWithOptions creates a Client configured using the given options.
+// Environment variables are applied as they are by NewClient, before the options.
+func NewClientWithOptions(opts ...Option) (*Client, error) {
+	var hc *http.Client
+
+	for _, opt := range opts {
+		if o, ok := opt.(httpClientOption); ok {
+			hc = o.hc
+		}
+	}
+
+	client := NewClient(hc)
+	if client.envErr != nil {
+		return nil, client.envErr
+	}
+
+	if err := client.applyOptions(opts); err != nil {
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+// With returns a new Client with the configuration of c and the given options
+// applied. Changes made to the derived client do not affect c and vice versa,
+// which makes With the safe way to create request-scoped clients (e.g. with a
+// different token) from a client used concurrently, since setters such as
+// SetToken are not safe for concurrent use.
+//
+// The derived client shares the transport (and its connection pool), rate limiter
+// and registered handlers of c. The response cache is shared as well, unless the
+// derived client uses different credentials or a different API URL.
+func (c *Client) With(opts ...Option) (*Client, error) {
+	// Copy the http.Client so that e.g. timeouts can be changed independently
+	hc := *c.resty.GetClient()
+
+	for _, opt := range opts {
+		if o, ok := opt.(httpClientOption); ok && o.hc != nil {
+			hc = *o.hc
+		}
+	}
+
+	derived := newClient(&hc)
+	derived.inherit(c)
+
+	// Profiles loaded lazily by c are loaded eagerly to avoid sharing state
+	if c.selectedProfile != "" && c.loadedProfile != c.selectedProfile {
+		if err := derived.UseProfile(c.selectedProfile); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := derived.applyOptions(opts); err != nil {
+		return nil, err
+	}
+
+	if derived.cache == c.cache && !derived.sameCredentials(c) {
+		derived.cache = NewLRUCache(APIDefaultCacheMaxEntries)
+		derived.cacheState = &clientCacheState{}
+	}
+
+	return &derived, nil
+}
+
+func (c *Client) applyOptions(opts []Option) error {
+	for _, opt := range opts {
+		if err := opt.apply(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameCredentials returns whether c makes requests to the same API URL
+// using the same credentials as the other client.
+func (c *Client) sameCredentials(other *Client) bool {
+	return c.resty.BaseURL == other.resty.BaseURL &&
+		c.resty.Header.Get("Authorization") == other.resty.Header.Get("Authorization") &&
+		c.tokenSource.source.Load() == other.tokenSource.source.Load()
+}
+
+// inherit copies the configuration of the parent client into c,
+// which must have been created using newClient.
+func (c *Client) inherit(parent *Client) {
+	c.userAgent = parent.userAgent
+	c.pollInterval = parent.pollInterval
+	c.pageConcurrency = parent.pageConcurrency
+
+	c.baseURL = parent.baseURL
+	c.apiVersion = parent.apiVersion
+	c.apiProto = parent.apiProto
+	c.updateHostURL()
+
+	c.selectedProfile = parent.selectedProfile
+	c.loadedProfile = parent.loadedProfile
+	c.configProfiles = maps.Clone(parent.configProfiles)
+	c.removedProfiles = slices.Clone(parent.removedProfiles)
+	c.useProfileDefaults = parent.useProfileDefaults
+
+	if parent.profileBaseline != nil {
+		baseline := *parent.profileBaseline
+		baseline.headers = maps.Clone(baseline.headers)
+		c.profileBaseline = &baseline
+	}
+
+	// Headers include the User-Agent and Authorization headers
+	c.resty.Header = parent.resty.Header.Clone()
+	c.http.header = parent.http.header.Clone()
+	c.http.userAgent = parent.http.userAgent
+
+	c.shouldCache = parent.shouldCache
+	c.cacheExpiration = parent.cacheExpiration
+	c.cacheStaleWindow = parent.cacheStaleWindow
+	c.cache = parent.cache
+	c.cacheState = parent.cacheState
+	c.cacheDependencies = slices.Clone(parent.cacheDependencies)
+
+	c.useHTTP = parent.useHTTP
+	if parent.http.httpClient != parent.resty.GetClient() {
+		// A separate transport was configured using UseHTTPTransport
+		c.http.httpClient = parent.http.httpClient
+	}
+
+	c.logState.logger.Store(parent.logState.logger.Load())
+	c.logState.custom.Store(parent.logState.custom.Load())
+
+	parent.telemetry.mu.RLock()
+	c.telemetry.tracer = parent.telemetry.tracer
+	c.telemetry.requests = parent.telemetry.requests
+	c.telemetry.duration = parent.telemetry.duration
+	c.telemetry.retries = parent.telemetry.retries
+	parent.telemetry.mu.RUnlock()
+
+	parent.maintenance.mu.Lock()
+	c.maintenance.opts = parent.maintenance.opts
+	c.maintenance.active = parent.maintenance.active
+	c.maintenance.until = parent.maintenance.until
+	parent.maintenance.mu.Unlock()
+
+	c.rateLimit.limiter.Store(parent.rateLimit.limiter.Load())
+	c.tokenSource.source.Store(parent.tokenSource.source.Load())
+	c.dryRun.recorder.Store(parent.dryRun.recorder.Load())
+
+	c.retryState.policy.Store(parent.retryState.get())
+	c.applyRetryPolicy()
+
+	c.inheritHooks(parent.hooks)
+	c.SetDebug(parent.debug)
+}
+
+// inheritHooks registers the handlers registered with a parent client.
+func (c *Client) inheritHooks(hooks clientHooks) {
+	for _, m := range hooks.beforeRequest {
+		c.OnBeforeRequest(m)
+	}
+
+	for _, m := range hooks.afterResponse {
+		c.OnAfterResponse(m)
+	}
+
+	for _, m := range hooks.beforeHTTPRequest {
+		c.OnBeforeHTTPRequest(m)
+	}
+
+	for _, m := range hooks.afterHTTPResponse {
+		c.OnAfterHTTPResponse(m)
+	}
+
+	for _, condition := range hooks.retryConditions {
+		c.AddRetryCondition(condition)
+	}
+
+	if hooks.retryAfter != nil {
+		c.SetRetryAfter(hooks.retryAfter)
+	}
+
+	if hooks.logger != nil {
+		c.SetLogger(hooks.logger)
+	}
+}
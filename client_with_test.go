@@ -0,0 +1,92 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+
+	client, err := NewClientWithOptions(
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithURL("https://api.test.linode.com/v4beta"),
+		WithToken("my-token"),
+		WithUserAgent("my-agent"),
+		WithHeader("X-Team", "infra"),
+		WithRetryCount(0),
+		WithPollDelay(time.Second),
+	)
+	require.NoError(t, err)
+
+	transport.RegisterResponder("GET", "https://api.test.linode.com/v4beta/profile",
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "Bearer my-token", req.Header.Get("Authorization"))
+			require.Equal(t, "my-agent", req.Header.Get("User-Agent"))
+			require.Equal(t, "infra", req.Header.Get("X-Team"))
+
+			return httpmock.NewJsonResponse(http.StatusOK, Profile{Username: "user"})
+		})
+
+	profile, err := client.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "user", profile.Username)
+	require.Equal(t, time.Second, client.GetPollDelay())
+	require.Equal(t, 1, client.GetRetryPolicy().MaxAttempts)
+
+	_, err = NewClientWithOptions(WithProfile("missing"))
+	require.Error(t, err)
+}
+
+func TestClient_With(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetToken("parent-token")
+
+	var hookCalls int
+
+	client.OnBeforeRequest(func(*Request) error {
+		hookCalls++
+		return nil
+	})
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/profile"),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, Profile{
+				Username: req.URL.Path + " " + req.Header.Get("Authorization"),
+			})
+		})
+
+	derived, err := client.With(WithToken("child-token"), WithAPIVersion("v4beta"))
+	require.NoError(t, err)
+
+	// The transport is shared while the configuration is not
+	require.Same(t, client.resty.GetClient().Transport, derived.resty.GetClient().Transport)
+
+	profile, err := derived.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "/v4beta/profile Bearer child-token", profile.Username)
+
+	profile, err = client.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "/v4/profile Bearer parent-token", profile.Username)
+
+	// Handlers registered with the parent are registered with derived clients
+	require.Equal(t, 2, hookCalls)
+
+	// Clients using different credentials don't share cached responses
+	require.NotSame(t, client.cacheState, derived.cacheState)
+
+	sibling, err := client.With(WithHeader("X-Team", "infra"))
+	require.NoError(t, err)
+	require.Same(t, client.cacheState, sibling.cacheState)
+	require.Empty(t, client.resty.Header.Get("X-Team"))
+
+	_, err = client.With(WithURL("://invalid"))
+	require.Error(t, err)
+}
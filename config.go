@@ -1,9 +1,14 @@
 package linodego
 
 import (
+	"bytes"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"gopkg.in/ini.v1"
 )
@@ -17,10 +22,62 @@ var DefaultConfigPaths = []string{
 	"%s/.config/linode-cli",
 }
 
+// ConfigProfile is a profile of a Linode config file, which may be shared with the linode-cli.
 type ConfigProfile struct {
 	APIToken   string `ini:"token"`
 	APIVersion string `ini:"api_version"`
 	APIURL     string `ini:"api_url"`
+
+	// Defaults for created resources, applied if enabled using Client.UseProfileDefaults
+	Region           string   `ini:"region"`
+	Type             string   `ini:"type"`
+	Image            string   `ini:"image"`
+	AuthorizedUsers  []string `ini:"authorized_users" delim:","`
+	MySQLEngine      string   `ini:"mysql_engine"`
+	PostgreSQLEngine string   `ini:"postgresql_engine"`
+
+	// Transport settings, see ClientOptions
+	CACertPath     string        `ini:"ca_cert"`
+	ClientCertPath string        `ini:"client_cert"`
+	ClientKeyPath  string        `ini:"client_key"`
+	ProxyURL       string        `ini:"proxy"`
+	Timeout        time.Duration `ini:"timeout"`
+
+	// Headers are read from keys prefixed with "header.", e.g. "header.X-Team = infra"
+	Headers map[string]string `ini:"-"`
+}
+
+// configHeaderKeyPrefix is the prefix of the config keys of additional request headers.
+const configHeaderKeyPrefix = "header."
+
+// clientOptions returns the transport settings of the profile.
+func (p ConfigProfile) clientOptions() ClientOptions {
+	return ClientOptions{
+		CACertPath:     p.CACertPath,
+		ClientCertPath: p.ClientCertPath,
+		ClientKeyPath:  p.ClientKeyPath,
+		ProxyURL:       p.ProxyURL,
+		Timeout:        p.Timeout,
+		Headers:        p.Headers,
+	}
+}
+
+// readConfigHeaders adds the headers of the given section to the given headers.
+func readConfigHeaders(section *ini.Section, headers map[string]string) map[string]string {
+	for _, key := range section.Keys() {
+		name, ok := strings.CutPrefix(key.Name(), configHeaderKeyPrefix)
+		if !ok {
+			continue
+		}
+
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+
+		headers[name] = key.Value()
+	}
+
+	return headers
 }
 
 type LoadConfigOptions struct {
@@ -29,6 +86,12 @@ type LoadConfigOptions struct {
 	SkipLoadProfile bool
 }
 
+type SaveConfigOptions struct {
+	// Path is the path of the config file to write. If empty, the config file
+	// found in the default config paths is used, or ~/.config/linode if none exists.
+	Path string
+}
+
 // LoadConfig loads a Linode config according to the option's argument.
 // If no options are specified, the following defaults will be used:
 // Path: ~/.config/linode
@@ -51,50 +114,86 @@ func (c *Client) LoadConfig(options *LoadConfigOptions) error {
 		}
 	}
 
-	cfg, err := ini.Load(path)
+	result, err := loadConfigProfiles(path)
 	if err != nil {
 		return err
 	}
 
-	defaultConfig := ConfigProfile{
-		APIToken:   "",
-		APIURL:     APIHost,
-		APIVersion: APIVersion,
-	}
+	c.configProfiles = result
 
-	if cfg.HasSection("default") {
-		err := cfg.Section("default").MapTo(&defaultConfig)
-		if err != nil {
-			return fmt.Errorf("failed to map default profile: %w", err)
+	if !options.SkipLoadProfile {
+		if err := c.UseProfile(profileOption); err != nil {
+			return fmt.Errorf("unable to use profile %s: %w", profileOption, err)
 		}
 	}
 
+	return nil
+}
+
+// loadConfigProfiles reads all profiles from the config file at the given path,
+// keyed by their lowercase name. Values missing from a profile are inherited
+// from the default profile (see inheritedConfigProfile).
+func loadConfigProfiles(path string) (map[string]ConfigProfile, error) {
+	cfg, err := ini.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make(map[string]ConfigProfile)
 
-	for _, profile := range cfg.Sections() {
-		name := strings.ToLower(profile.Name())
+	for _, section := range cfg.Sections() {
+		// The DEFAULT section holds values shared by all profiles, e.g. the
+		// default-user of the linode-cli, rather than a profile
+		if section.Name() == ini.DefaultSection {
+			continue
+		}
+
+		f, err := inheritedConfigProfile(cfg, section.Name())
+		if err != nil {
+			return nil, err
+		}
 
-		f := defaultConfig
-		if err := profile.MapTo(&f); err != nil {
-			return fmt.Errorf("failed to map values: %w", err)
+		if err := section.MapTo(&f); err != nil {
+			return nil, fmt.Errorf("failed to map values: %w", err)
 		}
 
-		result[name] = f
+		f.Headers = readConfigHeaders(section, f.Headers)
+
+		result[strings.ToLower(section.Name())] = f
 	}
 
-	c.configProfiles = result
+	return result, nil
+}
 
-	if !options.SkipLoadProfile {
-		if err := c.UseProfile(profileOption); err != nil {
-			return fmt.Errorf("unable to use profile %s: %w", profileOption, err)
+// inheritedConfigProfile returns the values inherited by the profile of the given section:
+// linodego's defaults, overridden by the values of the DEFAULT section and the default profile.
+func inheritedConfigProfile(cfg *ini.File, name string) (ConfigProfile, error) {
+	result := ConfigProfile{
+		APIURL:     APIHost,
+		APIVersion: APIVersion,
+	}
+
+	sections := []*ini.Section{cfg.Section(ini.DefaultSection)}
+
+	if name != DefaultConfigProfile && cfg.HasSection(DefaultConfigProfile) {
+		sections = append(sections, cfg.Section(DefaultConfigProfile))
+	}
+
+	for _, section := range sections {
+		if err := section.MapTo(&result); err != nil {
+			return ConfigProfile{}, fmt.Errorf("failed to map default profile: %w", err)
 		}
+
+		result.Headers = readConfigHeaders(section, maps.Clone(result.Headers))
 	}
 
-	return nil
+	return result, nil
 }
 
 // UseProfile switches client to use the specified profile.
 // The specified profile must be already be loaded using client.LoadConfig(...)
+// The transport settings, headers and timeout of the previously used profile
+// are reset before those of the specified profile are applied.
 func (c *Client) UseProfile(name string) error {
 	name = strings.ToLower(name)
 
@@ -115,7 +214,12 @@ func (c *Client) UseProfile(name string) error {
 		return fmt.Errorf("unable to resolve linode_api_version for profile %s", name)
 	}
 
-	c.SetToken(profile.APIToken)
+	if err := c.useProfileClientOptions(profile.clientOptions()); err != nil {
+		return fmt.Errorf("invalid settings for profile %s: %w", name, err)
+	}
+
+	// A TokenSource set on the client still takes precedence over the profile's token
+	c.setToken(profile.APIToken)
 	c.SetBaseURL(profile.APIURL)
 	c.SetAPIVersion(profile.APIVersion)
 	c.selectedProfile = name
@@ -124,6 +228,275 @@ func (c *Client) UseProfile(name string) error {
 	return nil
 }
 
+// AddProfile adds the given profile to the client's loaded profiles,
+// replacing any existing profile with the same name.
+func (c *Client) AddProfile(name string, profile ConfigProfile) {
+	name = strings.ToLower(name)
+
+	if c.configProfiles == nil {
+		c.configProfiles = make(map[string]ConfigProfile)
+	}
+
+	c.configProfiles[name] = profile
+	c.removedProfiles = slices.DeleteFunc(c.removedProfiles, func(removed string) bool {
+		return removed == name
+	})
+}
+
+// RemoveProfile removes the specified profile from the client's loaded profiles.
+// The profile is removed from the config file by the next call to SaveConfig(...).
+func (c *Client) RemoveProfile(name string) error {
+	name = strings.ToLower(name)
+
+	if _, ok := c.configProfiles[name]; !ok {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	delete(c.configProfiles, name)
+	c.removedProfiles = append(c.removedProfiles, name)
+
+	return nil
+}
+
+// SaveConfig writes the client's loaded profiles to a Linode config file.
+// Sections and values of an existing config file that are not managed by
+// linodego (e.g. linode-cli settings) are preserved.
+func (c *Client) SaveConfig(options *SaveConfigOptions) error {
+	var path string
+
+	if options != nil {
+		path = options.Path
+	}
+
+	if path == "" {
+		var err error
+		if path, err = resolveValidConfigPath(); err != nil {
+			return err
+		}
+	}
+
+	if path == "" {
+		var err error
+		if path, err = FormatConfigPath(DefaultConfigPaths[0]); err != nil {
+			return err
+		}
+	}
+
+	cfg, err := ini.LooseLoad(path)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range c.removedProfiles {
+		if section := findConfigSection(cfg, name); section != nil {
+			cfg.DeleteSection(section.Name())
+		}
+	}
+
+	names := make([]string, 0, len(c.configProfiles))
+	for name := range c.configProfiles {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	// The inherited values are resolved before any section is written,
+	// so profiles keep inheriting values from an updated default profile
+	inherited := make(map[string]ConfigProfile, len(names))
+
+	for _, name := range names {
+		sectionName := name
+		if section := findConfigSection(cfg, name); section != nil {
+			sectionName = section.Name()
+		}
+
+		if inherited[name], err = inheritedConfigProfile(cfg, sectionName); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		section := findConfigSection(cfg, name)
+		if section == nil {
+			if section, err = cfg.NewSection(name); err != nil {
+				return err
+			}
+		}
+
+		writeConfigProfile(section, c.configProfiles[name], inherited[name])
+	}
+
+	var buf bytes.Buffer
+
+	// The DEFAULT section is written without a header by ini, which
+	// Python's configparser (used by the linode-cli) rejects
+	if len(cfg.Section(ini.DefaultSection).Keys()) > 0 {
+		buf.WriteString("[" + ini.DefaultSection + "]" + ini.LineBreak)
+	}
+
+	if _, err := cfg.WriteTo(&buf); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	// The config file contains API tokens, so it should only be readable by the current user
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
+
+// findConfigSection returns the section of the given config file for the given
+// lowercase profile name, preferring an exact match. The DEFAULT section is never returned.
+func findConfigSection(cfg *ini.File, name string) *ini.Section {
+	var result *ini.Section
+
+	for _, section := range cfg.Sections() {
+		switch {
+		case section.Name() == ini.DefaultSection:
+			continue
+		case section.Name() == name:
+			return section
+		case result == nil && strings.EqualFold(section.Name(), name):
+			result = section
+		}
+	}
+
+	return result
+}
+
+// configValue is the value of a key of a config profile.
+type configValue struct {
+	key   string
+	value string
+}
+
+// configValues returns the values of the keys of the given profile, excluding headers.
+func (p ConfigProfile) configValues() []configValue {
+	values := []configValue{
+		{"token", p.APIToken},
+		{"api_version", p.APIVersion},
+		{"api_url", p.APIURL},
+		{"region", p.Region},
+		{"type", p.Type},
+		{"image", p.Image},
+		{"authorized_users", strings.Join(p.AuthorizedUsers, ",")},
+		{"mysql_engine", p.MySQLEngine},
+		{"postgresql_engine", p.PostgreSQLEngine},
+		{"ca_cert", p.CACertPath},
+		{"client_cert", p.ClientCertPath},
+		{"client_key", p.ClientKeyPath},
+		{"proxy", p.ProxyURL},
+		{"timeout", ""},
+	}
+
+	if p.Timeout > 0 {
+		values[len(values)-1].value = p.Timeout.String()
+	}
+
+	return values
+}
+
+// writeConfigProfile writes the values of the given profile to the given section.
+// Values are only written if they are already set in the section or differ from
+// the values the section inherits, and the keys of empty values are removed.
+func writeConfigProfile(section *ini.Section, profile, inherited ConfigProfile) {
+	for _, key := range section.Keys() {
+		if name, ok := strings.CutPrefix(key.Name(), configHeaderKeyPrefix); ok {
+			if _, exists := profile.Headers[name]; !exists {
+				section.DeleteKey(key.Name())
+			}
+		}
+	}
+
+	for name, value := range profile.Headers {
+		key := configHeaderKeyPrefix + name
+
+		if inheritedValue, ok := inherited.Headers[name]; section.HasKey(key) || !ok || value != inheritedValue {
+			section.Key(key).SetValue(value)
+		}
+	}
+
+	inheritedValues := inherited.configValues()
+
+	for i, v := range profile.configValues() {
+		switch {
+		case v.value == "":
+			section.DeleteKey(v.key)
+		case section.HasKey(v.key) || v.value != inheritedValues[i].value:
+			section.Key(v.key).SetValue(v.value)
+		}
+	}
+}
+
+// UseProfileDefaults sets whether the defaults of the selected profile (e.g. region,
+// type and image) are applied to empty fields of the options of create requests
+// such as CreateInstance(...), CreateLKECluster(...) and CreateMySQLDatabase(...).
+func (c *Client) UseProfileDefaults(value bool) {
+	c.useProfileDefaults = value
+}
+
+// applyProfileDefaults applies the defaults of the selected profile
+// to the given create options if enabled.
+func (c *Client) applyProfileDefaults(opts any) {
+	if !c.useProfileDefaults {
+		return
+	}
+
+	profile, ok := c.configProfiles[c.selectedProfile]
+	if !ok {
+		return
+	}
+
+	setDefault := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+
+	switch o := opts.(type) {
+	case *InstanceCreateOptions:
+		setDefault(&o.Region, profile.Region)
+		setDefault(&o.Type, profile.Type)
+
+		// Instances created from a backup or without an image do not accept an image or users
+		if o.BackupID == 0 {
+			setDefault(&o.Image, profile.Image)
+
+			if o.Image != "" && len(o.AuthorizedUsers) == 0 {
+				o.AuthorizedUsers = slices.Clone(profile.AuthorizedUsers)
+			}
+		}
+	case *InstanceRebuildOptions:
+		setDefault(&o.Image, profile.Image)
+
+		if len(o.AuthorizedUsers) == 0 {
+			o.AuthorizedUsers = slices.Clone(profile.AuthorizedUsers)
+		}
+	case *LKEClusterCreateOptions:
+		setDefault(&o.Region, profile.Region)
+
+		// The node pools are copied to avoid modifying the caller's options
+		o.NodePools = slices.Clone(o.NodePools)
+		for i := range o.NodePools {
+			setDefault(&o.NodePools[i].Type, profile.Type)
+		}
+	case *MySQLCreateOptions:
+		setDefault(&o.Region, profile.Region)
+		setDefault(&o.Engine, profile.MySQLEngine)
+	case *PostgresCreateOptions:
+		setDefault(&o.Region, profile.Region)
+		setDefault(&o.Engine, profile.PostgreSQLEngine)
+	case *NodeBalancerCreateOptions:
+		setDefault(&o.Region, profile.Region)
+	case *VolumeCreateOptions:
+		// Volumes attached to a Linode are created in its region
+		if o.LinodeID == 0 {
+			setDefault(&o.Region, profile.Region)
+		}
+	}
+}
+
 func FormatConfigPath(path string) (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
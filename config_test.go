@@ -1,9 +1,17 @@
 package linodego
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"gopkg.in/ini.v1"
 )
 
 func TestConfig_LoadWithDefaults(t *testing.T) {
@@ -110,9 +118,10 @@ func TestConfig_NoDefaults(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if len(client.configProfiles) != 2 {
+	// The implicit DEFAULT section is not a profile
+	if len(client.configProfiles) != 1 {
 		fmt.Println(client.configProfiles)
-		t.Fatalf("mismatched profile count: %d != %d", len(client.configProfiles), 2)
+		t.Fatalf("mismatched profile count: %d != %d", len(client.configProfiles), 1)
 	}
 
 	p, ok := client.configProfiles["cool"]
@@ -168,3 +177,356 @@ const configNoDefaults = `
 token = mytoken
 # Linodego default values are inherited here
 `
+
+func TestConfig_ResourceDefaults(t *testing.T) {
+	client := NewClient(nil)
+
+	file := createTestConfig(t, configResourceDefaults)
+
+	err := client.LoadConfig(&LoadConfigOptions{
+		Path:    file.Name(),
+		Profile: "cool",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := ConfigProfile{
+		APIToken:         "mytoken",
+		APIURL:           APIHost,
+		APIVersion:       APIVersion,
+		Region:           "us-east",
+		Type:             "g6-standard-2",
+		Image:            "linode/ubuntu24.04",
+		AuthorizedUsers:  []string{"user1", "user2"},
+		MySQLEngine:      "mysql/8.0.30",
+		PostgreSQLEngine: "postgresql/16",
+	}
+
+	if diff := cmp.Diff(expected, client.configProfiles["cool"]); diff != "" {
+		t.Fatalf("mismatched profile:\n%s", diff)
+	}
+}
+
+func TestConfig_TransportSettings(t *testing.T) {
+	client := NewClient(nil)
+	client.SetHeader("X-Env", "production")
+
+	transport := client.resty.GetClient().Transport
+
+	file := createTestConfig(t, configTransportSettings)
+
+	err := client.LoadConfig(&LoadConfigOptions{
+		Path:    file.Name(),
+		Profile: "cool",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := ConfigProfile{
+		APIToken:   "mytoken",
+		APIURL:     APIHost,
+		APIVersion: APIVersion,
+		ProxyURL:   "http://proxy.example.com:3128",
+		Timeout:    45 * time.Second,
+		Headers:    map[string]string{"X-Team": "infra", "X-Env": "staging"},
+	}
+
+	if diff := cmp.Diff(expected, client.configProfiles["cool"]); diff != "" {
+		t.Fatalf("mismatched profile:\n%s", diff)
+	}
+
+	if client.resty.GetClient().Timeout != 45*time.Second {
+		t.Fatalf("mismatched timeout: %s", client.resty.GetClient().Timeout)
+	}
+
+	if client.resty.Header.Get("X-Team") != "infra" || client.resty.Header.Get("X-Env") != "staging" {
+		t.Fatalf("missing headers: %v", client.resty.Header)
+	}
+
+	// Inherited headers are not shared between profiles
+	if diff := cmp.Diff(map[string]string{"X-Team": "infra"}, client.configProfiles["default"].Headers); diff != "" {
+		t.Fatalf("mismatched default headers:\n%s", diff)
+	}
+
+	// The timeout also applies to the net/http execution path
+	client.UseHTTPTransport(httpmock.DefaultTransport)
+
+	if client.http.httpClient.Timeout != 45*time.Second {
+		t.Fatalf("mismatched net/http timeout: %s", client.http.httpClient.Timeout)
+	}
+
+	// Settings of the previous profile are reset when switching profiles
+	if err := client.UseProfile("plain"); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.resty.GetClient().Transport != transport {
+		t.Fatal("transport of previous profile was not reset")
+	}
+
+	if client.resty.GetClient().Timeout != 0 || client.http.httpClient.Timeout != 0 {
+		t.Fatalf("timeout of previous profile was not reset: %s", client.resty.GetClient().Timeout)
+	}
+
+	if client.resty.Header.Get("X-Env") != "production" || client.http.header.Get("X-Env") != "production" {
+		t.Fatalf("header of previous profile was not reset: %v", client.resty.Header)
+	}
+
+	if client.resty.Header.Get("X-Team") != "infra" {
+		t.Fatalf("missing headers: %v", client.resty.Header)
+	}
+
+	err = client.LoadConfig(&LoadConfigOptions{
+		Path:    file.Name(),
+		Profile: "broken",
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid proxy URL")
+	}
+}
+
+func TestConfig_Save(t *testing.T) {
+	client := NewClient(nil)
+
+	file := createTestConfig(t, configSave)
+
+	err := client.LoadConfig(&LoadConfigOptions{
+		Path:            file.Name(),
+		SkipLoadProfile: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.AddProfile("New", ConfigProfile{
+		APIToken:   "newtoken",
+		APIURL:     APIHost,
+		APIVersion: APIVersion,
+		Region:     "us-west",
+	})
+
+	if err := client.RemoveProfile("old"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.RemoveProfile("missing"); err == nil {
+		t.Fatal("expected an error when removing a missing profile")
+	}
+
+	if err := client.SaveConfig(&SaveConfigOptions{Path: file.Name()}); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("mismatched file mode: %v != %v", info.Mode().Perm(), os.FileMode(0o600))
+	}
+
+	saved, err := ini.Load(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if saved.HasSection("old") {
+		t.Fatal("removed profile was saved")
+	}
+
+	if v := saved.Section("new").Key("region").String(); v != "us-west" {
+		t.Fatalf("mismatched region: %s != %s", v, "us-west")
+	}
+
+	// Values not managed by linodego should be preserved
+	if v := saved.Section("cool").Key("plugin-setting").String(); v != "enabled" {
+		t.Fatalf("mismatched plugin setting: %s != %s", v, "enabled")
+	}
+
+	reloaded := NewClient(nil)
+
+	err = reloaded.LoadConfig(&LoadConfigOptions{
+		Path:            file.Name(),
+		SkipLoadProfile: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(client.configProfiles, reloaded.configProfiles); diff != "" {
+		t.Fatalf("mismatched profiles after save:\n%s", diff)
+	}
+}
+
+func TestConfig_SaveCLIConfig(t *testing.T) {
+	client := NewClient(nil)
+
+	file := createTestConfig(t, configCLI)
+	expected := readTestConfigValues(t, file.Name())
+
+	err := client.LoadConfig(&LoadConfigOptions{
+		Path:    file.Name(),
+		Profile: "alice",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := client.configProfiles[DefaultConfigProfile]; ok {
+		t.Fatal("DEFAULT section was loaded as a profile")
+	}
+
+	if err := client.SaveConfig(&SaveConfigOptions{Path: file.Name()}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Inherited values are not written to the profiles of the linode-cli config
+	if diff := cmp.Diff(expected, readTestConfigValues(t, file.Name())); diff != "" {
+		t.Fatalf("mismatched config after save:\n%s", diff)
+	}
+
+	profile := client.configProfiles["bob"]
+	profile.Region = "us-west"
+	client.AddProfile("bob", profile)
+
+	client.AddProfile("carol", ConfigProfile{
+		APIToken:   "carol-token",
+		APIURL:     APIHost,
+		APIVersion: APIVersion,
+	})
+
+	if err := client.SaveConfig(&SaveConfigOptions{Path: file.Name()}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected["bob"]["region"] = "us-west"
+	expected["carol"] = map[string]string{"token": "carol-token"}
+
+	if diff := cmp.Diff(expected, readTestConfigValues(t, file.Name())); diff != "" {
+		t.Fatalf("mismatched config after save:\n%s", diff)
+	}
+}
+
+// readTestConfigValues returns the values of each section of the given config file.
+// The file must start with a section header, as required by the linode-cli.
+func readTestConfigValues(t *testing.T, path string) map[string]map[string]string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(string(content), "[") {
+		t.Fatalf("config does not start with a section header:\n%s", content)
+	}
+
+	cfg, err := ini.Load(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := make(map[string]map[string]string)
+
+	for _, section := range cfg.Sections() {
+		result[section.Name()] = section.KeysHash()
+	}
+
+	return result
+}
+
+func TestConfig_UseProfileDefaults(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	client.AddProfile("cool", ConfigProfile{
+		APIToken:        "mytoken",
+		APIURL:          APIHost,
+		APIVersion:      APIVersion,
+		Region:          "us-east",
+		Type:            "g6-standard-2",
+		Image:           "linode/ubuntu24.04",
+		AuthorizedUsers: []string{"user1"},
+	})
+
+	if err := client.UseProfile("cool"); err != nil {
+		t.Fatal(err)
+	}
+
+	client.UseProfileDefaults(true)
+
+	httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/linode/instances"),
+		testutil.MockRequestBodyValidate(t, InstanceCreateOptions{
+			Region:          "us-east",
+			Type:            "g6-nanode-1",
+			Image:           "linode/ubuntu24.04",
+			AuthorizedUsers: []string{"user1"},
+		}, Instance{}))
+
+	// Explicitly set fields take precedence
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Type: "g6-nanode-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	client.UseProfileDefaults(false)
+
+	httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/linode/instances"),
+		testutil.MockRequestBodyValidate(t, InstanceCreateOptions{Type: "g6-nanode-1"}, Instance{}))
+
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Type: "g6-nanode-1"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+const configResourceDefaults = `
+[cool]
+token = mytoken
+region = us-east
+type = g6-standard-2
+image = linode/ubuntu24.04
+authorized_users = user1,user2
+mysql_engine = mysql/8.0.30
+postgresql_engine = postgresql/16
+`
+
+const configTransportSettings = `
+[default]
+header.X-Team = infra
+
+[cool]
+token = mytoken
+proxy = http://proxy.example.com:3128
+timeout = 45s
+header.X-Env = staging
+
+[plain]
+token = mytoken
+
+[broken]
+token = mytoken
+proxy = proxy.example.com
+`
+
+const configCLI = `[DEFAULT]
+default-user = alice
+
+[alice]
+token = alice-token
+region = us-east
+type = g6-standard-2
+image = linode/ubuntu24.04
+authorized_users = alice
+mysql_engine = mysql/8.0.30
+
+[bob]
+token = bob-token
+`
+
+const configSave = `
+[cool]
+token = mytoken
+plugin-setting = enabled
+
+[old]
+token = oldtoken
+`
@@ -0,0 +1,161 @@
+package linodego
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// PlannedOperation is a mutating request recorded by a client in dry-run mode.
+type PlannedOperation struct {
+	// Method is the HTTP method of the request, e.g. POST.
+	Method string `json:"method"`
+
+	// Path is the API endpoint of the request, e.g. linode/instances/123/boot.
+	Path string `json:"path"`
+
+	// Body is the JSON body of the request, if any.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// DryRun records the mutating requests (POST, PUT and DELETE) made by a client
+// instead of sending them to the API. GET requests are sent as usual, as are requests
+// creating child account tokens (CreateChildAccountToken), which child account clients
+// need to plan their own requests. Other tokens, e.g. from CreateToken, are recorded.
+//
+// Mutating requests return a synthetic response built from the request body.
+// Resources created in dry-run mode are assigned placeholder IDs: negative numbers
+// for numeric IDs (e.g. Instance.ID) and "dry-run-<n>" for string IDs.
+type DryRun struct {
+	mu sync.Mutex
+
+	operations []PlannedOperation
+	count      int
+}
+
+// NewDryRun creates a DryRun to be used with Client.SetDryRun.
+func NewDryRun() *DryRun {
+	return &DryRun{}
+}
+
+// Operations returns the operations recorded so far, in the order they were made.
+func (d *DryRun) Operations() []PlannedOperation {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]PlannedOperation(nil), d.operations...)
+}
+
+// Reset discards the recorded operations.
+func (d *DryRun) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.operations = nil
+	d.count = 0
+}
+
+// record records the given request and fills its response with a synthetic result.
+func (d *DryRun) record(method, endpoint string, params RequestParams) error {
+	var body json.RawMessage
+
+	if params.Body != nil {
+		var err error
+		if body, err = json.Marshal(params.Body); err != nil {
+			return err
+		}
+	}
+
+	d.mu.Lock()
+	d.operations = append(d.operations, PlannedOperation{Method: method, Path: strings.Trim(endpoint, "/"), Body: body})
+	d.count++
+	placeholder := d.count
+	d.mu.Unlock()
+
+	if params.Response == nil {
+		return nil
+	}
+
+	if body != nil {
+		// The response is built on a best-effort basis, since the fields
+		// of options don't necessarily match those of the resource
+		_ = json.Unmarshal(body, params.Response)
+	}
+
+	setDryRunID(params.Response, method, endpoint, placeholder)
+
+	return nil
+}
+
+// setDryRunID sets the ID field of the given response. Updated resources keep the ID
+// of the updated endpoint while other resources are assigned a placeholder ID.
+func setDryRunID(response any, method, endpoint string, placeholder int) {
+	v := reflect.ValueOf(response)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	field := v.Elem().FieldByName("ID")
+	if !field.IsValid() || !field.CanSet() {
+		return
+	}
+
+	id := path.Base(endpoint)
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int64:
+		if parsed, err := strconv.Atoi(id); err == nil && method == http.MethodPut {
+			field.SetInt(int64(parsed))
+		} else {
+			field.SetInt(int64(-placeholder))
+		}
+	case reflect.String:
+		if method == http.MethodPut {
+			field.SetString(id)
+		} else {
+			field.SetString(fmt.Sprintf("dry-run-%d", placeholder))
+		}
+	}
+}
+
+type dryRunState struct {
+	recorder atomic.Pointer[DryRun]
+}
+
+// SetDryRun configures the client to record mutating requests using the given
+// DryRun rather than sending them to the API. Passing nil disables dry-run mode.
+// Requests created directly using R(...) are always sent.
+func (c *Client) SetDryRun(dryRun *DryRun) *Client {
+	c.dryRun.recorder.Store(dryRun)
+	return c
+}
+
+// dryRunSentEndpoints are patterns matching the endpoints of mutating requests that
+// only create short-lived tokens used to make further requests, which are sent even
+// in dry-run mode.
+var dryRunSentEndpoints = []string{
+	"account/child-accounts/*/token",
+}
+
+// get returns the DryRun recording requests with the given method and endpoint, if any.
+func (s *dryRunState) get(method, endpoint string) *DryRun {
+	if method == http.MethodGet {
+		return nil
+	}
+
+	endpoint = strings.Trim(endpoint, "/")
+
+	for _, pattern := range dryRunSentEndpoints {
+		if ok, _ := path.Match(pattern, endpoint); ok {
+			return nil
+		}
+	}
+
+	return s.recorder.Load()
+}
@@ -0,0 +1,80 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		dryRun := NewDryRun()
+		client.SetDryRun(dryRun)
+
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/123"),
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, Instance{ID: 123, Label: "existing"}))
+
+		// GET requests are sent as usual
+		existing, err := client.GetInstance(context.Background(), 123)
+		require.NoError(t, err)
+		require.Equal(t, "existing", existing.Label)
+
+		instance, err := client.CreateInstance(context.Background(), InstanceCreateOptions{
+			Label:  "planned",
+			Region: "us-east",
+			Type:   "g6-standard-1",
+		})
+		require.NoError(t, err)
+		require.Equal(t, -1, instance.ID)
+		require.Equal(t, "planned", instance.Label)
+		require.Equal(t, "us-east", instance.Region)
+
+		require.NoError(t, client.BootInstance(context.Background(), instance.ID, 0))
+
+		updated, err := client.UpdateInstance(context.Background(), 123, InstanceUpdateOptions{Label: "renamed"})
+		require.NoError(t, err)
+		require.Equal(t, 123, updated.ID)
+		require.Equal(t, "renamed", updated.Label)
+
+		require.NoError(t, client.DeleteInstance(context.Background(), 123))
+
+		// Paths of endpoints with a leading slash are normalized
+		_, err = client.AppendInstanceConfigInterface(context.Background(), 123, 456, InstanceConfigInterfaceCreateOptions{})
+		require.NoError(t, err)
+
+		operations := dryRun.Operations()
+		require.Len(t, operations, 5)
+		require.Equal(t, PlannedOperation{Method: http.MethodPost, Path: "linode/instances/-1/boot", Body: []byte("{}")}, operations[1])
+		require.JSONEq(t, `{"label": "renamed"}`, string(operations[2].Body))
+		require.Equal(t, PlannedOperation{Method: http.MethodDelete, Path: "linode/instances/123"}, operations[3])
+		require.Equal(t, "linode/instances/123/configs/456/interfaces", operations[4].Path)
+
+		// Requests creating tokens are sent, so child account clients keep working
+		httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/account/child-accounts/child-euuid/token"),
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, ChildAccountToken{Token: "child-token"}))
+
+		child := NewClient(nil)
+		child.SetTokenSource(NewChildAccountTokenSource(client, "child-euuid"))
+
+		token, err := child.tokenSource.authorization(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "Bearer child-token", token)
+		require.Len(t, dryRun.Operations(), 5)
+
+		// Personal access tokens are long-lived, so creating them is recorded
+		_, err = client.CreateToken(context.Background(), TokenCreateOptions{Label: "planned"})
+		require.NoError(t, err)
+		require.Len(t, dryRun.Operations(), 6)
+		require.Equal(t, "profile/tokens", dryRun.Operations()[5].Path)
+
+		// Only the GET request and the child account token request reached the API
+		require.Equal(t, 2, httpmock.GetTotalCallCount())
+
+		dryRun.Reset()
+		require.Empty(t, dryRun.Operations())
+	})
+}
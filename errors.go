@@ -22,11 +22,36 @@ const (
 	ErrorFromStringer
 )
 
+// Sentinel errors matching Errors returned by the Linode API, for use with errors.Is.
+var (
+	// ErrNotFound matches 404 Not Found errors
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized matches 401 Unauthorized errors
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches 403 Forbidden errors
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict matches 409 Conflict errors
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited matches 429 Too Many Requests errors
+	ErrRateLimited = errors.New("rate limited")
+	// ErrLinodeBusy matches "Linode busy." errors, returned when a Linode
+	// cannot be modified because another operation is in progress
+	ErrLinodeBusy = errors.New("linode busy")
+)
+
 // Error wraps the LinodeGo error with the relevant http.Response
 type Error struct {
 	Response *http.Response
 	Code     int
 	Message  string
+
+	// Err is the underlying error, if any (e.g. a *MaintenanceError)
+	Err error
+
+	// Metadata describes the API response that caused the error, if any.
+	// It is only set for requests made using the Client's endpoint methods,
+	// not for requests made using the resty.Request returned by Client.R.
+	Metadata *ResponseMetadata
 }
 
 // APIErrorReason is an individual invalid request message returned by the Linode API
@@ -46,6 +71,11 @@ func (r APIErrorReason) Error() string {
 // APIError is the error-set returned by the Linode API when presented with an invalid request
 type APIError struct {
 	Errors []APIErrorReason `json:"errors"`
+
+	// Metadata describes the API response that returned the errors, if any.
+	// It is only set for requests made using the Client's endpoint methods,
+	// not for requests made using the resty.Request returned by Client.R.
+	Metadata *ResponseMetadata `json:"-"`
 }
 
 // String returns the error reason in a formatted string
@@ -66,6 +96,10 @@ func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 
 	// handle the resty Response errors
 
+	if maintenanceError := newMaintenanceError(r.StatusCode(), r.Header()); maintenanceError != nil {
+		return nil, coupleMaintenanceError(r.RawResponse, maintenanceError)
+	}
+
 	// Check that response is of the correct content-type before unmarshalling
 	expectedContentType := r.Request.Header.Get("Accept")
 	responseContentType := r.Header().Get("Content-Type")
@@ -96,14 +130,17 @@ func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 	return nil, NewError(r)
 }
 
-//nolint:unused
 func coupleAPIErrorsHTTP(resp *http.Response, err error) (*http.Response, error) {
 	if err != nil {
 		// an error was raised in go code, no need to check the http.Response
 		return nil, NewError(err)
 	}
 
-	if resp == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+	if resp != nil && (resp.StatusCode < 200 || resp.StatusCode >= 300) {
+		if maintenanceError := newMaintenanceError(resp.StatusCode, resp.Header); maintenanceError != nil {
+			return nil, coupleMaintenanceError(resp, maintenanceError)
+		}
+
 		// Check that response is of the correct content-type before unmarshalling
 		expectedContentType := resp.Request.Header.Get("Accept")
 		responseContentType := resp.Header.Get("Content-Type")
@@ -135,7 +172,7 @@ func coupleAPIErrorsHTTP(resp *http.Response, err error) (*http.Response, error)
 			return resp, nil
 		}
 
-		return nil, Error{Code: resp.StatusCode, Message: apiError.Errors[0].String()}
+		return nil, &Error{Code: resp.StatusCode, Message: apiError.Error(), Response: resp, Err: &apiError}
 	}
 
 	// no error in the http.Response
@@ -159,7 +196,28 @@ func (err Error) StatusCode() int {
 	return err.Code
 }
 
+// Unwrap returns the underlying error, if any.
+func (err Error) Unwrap() error {
+	return err.Err
+}
+
 func (err Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return err.Code == http.StatusNotFound
+	case ErrUnauthorized:
+		return err.Code == http.StatusUnauthorized
+	case ErrForbidden:
+		return err.Code == http.StatusForbidden
+	case ErrConflict:
+		return err.Code == http.StatusConflict
+	case ErrRateLimited:
+		return err.Code == http.StatusTooManyRequests
+	case ErrLinodeBusy:
+		apiError := err.apiError()
+		return err.Code == http.StatusBadRequest && apiError != nil && apiError.Error() == "Linode busy."
+	}
+
 	if x, ok := target.(interface{ StatusCode() int }); ok || errors.As(target, &x) {
 		return err.StatusCode() == x.StatusCode()
 	}
@@ -191,6 +249,7 @@ func NewError(err any) *Error {
 			Code:     e.RawResponse.StatusCode,
 			Message:  apiError.Error(),
 			Response: e.RawResponse,
+			Err:      apiError,
 		}
 	case error:
 		return &Error{Code: ErrorFromError, Message: e.Error()}
@@ -203,6 +262,51 @@ func NewError(err any) *Error {
 	}
 }
 
+// apiError returns the APIError returned by the Linode API, if any.
+func (err Error) apiError() *APIError {
+	var apiError *APIError
+	if errors.As(err.Err, &apiError) {
+		return apiError
+	}
+
+	return nil
+}
+
+// FieldErrors returns the reasons returned by the Linode API for the error,
+// keyed by the request field they relate to. Reasons that do not relate to
+// a specific field are keyed by an empty string.
+func (err Error) FieldErrors() map[string][]string {
+	apiError := err.apiError()
+	if apiError == nil || len(apiError.Errors) == 0 {
+		return nil
+	}
+
+	result := make(map[string][]string, len(apiError.Errors))
+	for _, reason := range apiError.Errors {
+		result[reason.Field] = append(result[reason.Field], reason.Reason)
+	}
+
+	return result
+}
+
+// IsTemporary returns whether the error is caused by a transient condition
+// that is expected to resolve itself, e.g. rate limiting, maintenance or a
+// Linode being busy.
+func (err Error) IsTemporary() bool {
+	switch err.Code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return err.Is(ErrLinodeBusy)
+}
+
+// IsRetryable returns whether the failed request can be retried as-is,
+// i.e. the error is temporary or the request timed out.
+func (err Error) IsRetryable() bool {
+	return err.IsTemporary() || err.Code == http.StatusRequestTimeout
+}
+
 // IsNotFound indicates if err indicates a 404 Not Found error from the Linode API.
 func IsNotFound(err error) bool {
 	return ErrHasStatus(err, http.StatusNotFound)
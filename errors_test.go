@@ -516,3 +516,102 @@ func TestErrHasStatusCode(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorSentinels(t *testing.T) {
+	busy := &Error{
+		Code: http.StatusBadRequest,
+		Err:  &APIError{Errors: []APIErrorReason{{Reason: "Linode busy."}}},
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		sentinel error
+		match    bool
+	}{
+		{name: "not found", err: &Error{Code: http.StatusNotFound}, sentinel: ErrNotFound, match: true},
+		{name: "wrapped not found", err: fmt.Errorf("wrap: %w", &Error{Code: http.StatusNotFound}), sentinel: ErrNotFound, match: true},
+		{name: "unauthorized", err: &Error{Code: http.StatusUnauthorized}, sentinel: ErrUnauthorized, match: true},
+		{name: "forbidden", err: Error{Code: http.StatusForbidden}, sentinel: ErrForbidden, match: true},
+		{name: "conflict", err: &Error{Code: http.StatusConflict}, sentinel: ErrConflict, match: true},
+		{name: "rate limited", err: &Error{Code: http.StatusTooManyRequests}, sentinel: ErrRateLimited, match: true},
+		{name: "linode busy", err: busy, sentinel: ErrLinodeBusy, match: true},
+		{name: "bad request is not linode busy", err: &Error{Code: http.StatusBadRequest}, sentinel: ErrLinodeBusy},
+		{name: "not found is not forbidden", err: &Error{Code: http.StatusNotFound}, sentinel: ErrForbidden},
+		{name: "non-API error", err: errors.New("not found"), sentinel: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errors.Is(tt.err, tt.sentinel) != tt.match {
+				t.Errorf("expected errors.Is(%v, %v) to be %v", tt.err, tt.sentinel, tt.match)
+			}
+		})
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       *Error
+		temporary bool
+		retryable bool
+	}{
+		{name: "rate limited", err: &Error{Code: http.StatusTooManyRequests}, temporary: true, retryable: true},
+		{name: "service unavailable", err: &Error{Code: http.StatusServiceUnavailable}, temporary: true, retryable: true},
+		{
+			name: "linode busy",
+			err: &Error{
+				Code: http.StatusBadRequest,
+				Err:  &APIError{Errors: []APIErrorReason{{Reason: "Linode busy."}}},
+			},
+			temporary: true,
+			retryable: true,
+		},
+		{name: "request timeout", err: &Error{Code: http.StatusRequestTimeout}, retryable: true},
+		{name: "not found", err: &Error{Code: http.StatusNotFound}},
+		{name: "go error", err: NewError(errors.New("test"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err.IsTemporary() != tt.temporary {
+				t.Errorf("expected IsTemporary to be %v", tt.temporary)
+			}
+
+			if tt.err.IsRetryable() != tt.retryable {
+				t.Errorf("expected IsRetryable to be %v", tt.retryable)
+			}
+		})
+	}
+}
+
+func TestErrorFieldErrors(t *testing.T) {
+	if NewError(errors.New("test")).FieldErrors() != nil {
+		t.Error("expected no field errors for non-API errors")
+	}
+
+	err := NewError(&resty.Response{
+		RawResponse: &http.Response{StatusCode: http.StatusBadRequest},
+		Request: &resty.Request{
+			Error: &APIError{
+				Errors: []APIErrorReason{
+					{Field: "label", Reason: "Label must be unique"},
+					{Field: "label", Reason: "Label is too long"},
+					{Field: "region", Reason: "Region is required"},
+					{Reason: "Request is invalid"},
+				},
+			},
+		},
+	})
+
+	expected := map[string][]string{
+		"label":  {"Label must be unique", "Label is too long"},
+		"region": {"Region is required"},
+		"":       {"Request is invalid"},
+	}
+
+	if diff := cmp.Diff(expected, err.FieldErrors()); diff != "" {
+		t.Errorf("unexpected field errors:\n%s", diff)
+	}
+}
@@ -37,6 +37,12 @@ func (f *Filter) AddField(op FilterOperator, key string, value any) {
 	f.Children = append(f.Children, &Comp{key, op, value})
 }
 
+// Add adds the given nodes, such as comparisons built using the typed
+// filter fields or nested filters, to the children of the filter.
+func (f *Filter) Add(nodes ...FilterNode) {
+	f.Children = append(f.Children, nodes...)
+}
+
 func (f *Filter) MarshalJSON() ([]byte, error) {
 	result := make(map[string]any)
 
@@ -48,7 +54,9 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		result["+order"] = f.Order
 	}
 
-	if f.Operator == "" {
+	// Children with the same key replace each other unless they are combined using
+	// an operator, so nested filters are combined using an explicit "+and"
+	if f.Operator == "" && !f.hasNestedFilters() {
 		for _, c := range f.Children {
 			result[c.Key()] = c.JSONValueSegment()
 		}
@@ -56,6 +64,24 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		return json.Marshal(result)
 	}
 
+	result[f.Key()] = f.JSONValueSegment()
+
+	return json.Marshal(result)
+}
+
+// Key returns the operator of the filter, allowing filters to be nested in other filters.
+// The children of filters without an operator are combined using "+and".
+func (f *Filter) Key() string {
+	if f.Operator == "" {
+		return "+and"
+	}
+
+	return f.Operator
+}
+
+// JSONValueSegment returns the children of the filter. The order of nested
+// filters is ignored, as results can only be ordered by the outermost filter.
+func (f *Filter) JSONValueSegment() any {
 	fields := make([]map[string]any, len(f.Children))
 	for i, c := range f.Children {
 		fields[i] = map[string]any{
@@ -63,9 +89,17 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	result[f.Operator] = fields
+	return fields
+}
+
+func (f *Filter) hasNestedFilters() bool {
+	for _, c := range f.Children {
+		if _, ok := c.(*Filter); ok {
+			return true
+		}
+	}
 
-	return json.Marshal(result)
+	return false
 }
 
 type Comp struct {
@@ -0,0 +1,498 @@
+package linodego
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// UnmarshalJSON parses the JSON representation of a filter, e.g. the value of the
+// X-Filter header, into the filter.
+func (f *Filter) UnmarshalJSON(b []byte) error {
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	result, err := filterFromJSON(fields)
+	if err != nil {
+		return err
+	}
+
+	*f = *result
+
+	return nil
+}
+
+// filterFromJSON returns the filter with the given JSON representation.
+func filterFromJSON(fields map[string]any) (*Filter, error) {
+	result := &Filter{}
+
+	orderBy, _ := fields["+order_by"].(string)
+	order, _ := fields["+order"].(string)
+
+	// Sort the keys of the filter so the children are in a consistent order
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		if key != "+order_by" && key != "+order" {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		node, err := filterNodeFromJSON(key, fields[key])
+		if err != nil {
+			return nil, err
+		}
+
+		result.Add(node)
+	}
+
+	// Filters consisting of a single operator are represented by a Filter using it
+	if nested, ok := singleFilterNode(result).(*Filter); ok {
+		result = nested
+	}
+
+	result.OrderBy = orderBy
+	result.Order = order
+
+	return result, nil
+}
+
+// filterNodeFromJSON returns the node with the given key and JSON value.
+func filterNodeFromJSON(key string, value any) (FilterNode, error) {
+	switch key {
+	case "+and", "+or":
+		clauses, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a list", key)
+		}
+
+		result := &Filter{Operator: key}
+
+		for _, clause := range clauses {
+			fields, ok := clause.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a list of objects", key)
+			}
+
+			child, err := filterFromJSON(fields)
+			if err != nil {
+				return nil, err
+			}
+
+			result.Add(singleFilterNode(child))
+		}
+
+		return result, nil
+	}
+
+	if strings.HasPrefix(key, "+") {
+		return nil, fmt.Errorf("unknown operator %s", key)
+	}
+
+	operators, ok := value.(map[string]any)
+	if !ok {
+		return &Comp{key, Eq, value}, nil
+	}
+
+	names := make([]string, 0, len(operators))
+	for operator := range operators {
+		names = append(names, operator)
+	}
+
+	slices.Sort(names)
+
+	result := &Filter{Operator: "+and"}
+
+	for _, operator := range names {
+		if _, ok := filterComparisons[FilterOperator(operator)]; !ok && FilterOperator(operator) != Contains {
+			return nil, fmt.Errorf("unknown operator %s", operator)
+		}
+
+		result.Add(&Comp{key, FilterOperator(operator), operators[operator]})
+	}
+
+	return singleFilterNode(result), nil
+}
+
+// singleFilterNode returns the only child of filters with a single child
+// and without an order, or the filter itself.
+func singleFilterNode(f *Filter) FilterNode {
+	if len(f.Children) == 1 && f.OrderBy == "" && f.Order == "" {
+		return f.Children[0]
+	}
+
+	return f
+}
+
+// Matches returns whether the given result, a struct or a map, matches the filter
+// as it would be matched by the API. Fields are identified by their JSON names,
+// or by the snake case of their Go names if they are excluded from JSON, such as
+// timestamps parsed by custom JSON unmarshalers.
+func (f *Filter) Matches(result any) (bool, error) {
+	filter, err := f.evaluable()
+	if err != nil {
+		return false, err
+	}
+
+	return matchesFilter(filter, reflect.ValueOf(result))
+}
+
+// FilterResults returns the given results matching the filter, ordered by the
+// OrderBy and Order of the filter if set. See Filter.Matches.
+func FilterResults[T any](f *Filter, results []T) ([]T, error) {
+	filter, err := f.evaluable()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]T, 0, len(results))
+
+	for _, result := range results {
+		ok, err := matchesFilter(filter, reflect.ValueOf(result))
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			matched = append(matched, result)
+		}
+	}
+
+	if f.OrderBy != "" {
+		slices.SortStableFunc(matched, func(a, b T) int {
+			c := compareFilterValues(
+				filterFieldValue(reflect.ValueOf(a), f.OrderBy),
+				filterFieldValue(reflect.ValueOf(b), f.OrderBy),
+			)
+
+			if f.Order == Descending {
+				return -c
+			}
+
+			return c
+		})
+	}
+
+	return matched, nil
+}
+
+// evaluable returns the JSON representation of the filter, which is evaluated
+// rather than the filter itself to support any FilterNode implementation.
+func (f *Filter) evaluable() (map[string]any, error) {
+	b, err := f.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+
+	delete(result, "+order_by")
+	delete(result, "+order")
+
+	return result, nil
+}
+
+// matchesFilter returns whether the given result matches all conditions of the filter.
+func matchesFilter(filter map[string]any, result reflect.Value) (bool, error) {
+	for key, condition := range filter {
+		var (
+			ok  bool
+			err error
+		)
+
+		switch key {
+		case "+and", "+or":
+			ok, err = matchesFilterClauses(key, condition, result)
+		default:
+			if strings.HasPrefix(key, "+") {
+				return false, fmt.Errorf("unknown operator %s", key)
+			}
+
+			ok, err = matchesFilterCondition(filterFieldValue(result, key), condition)
+		}
+
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// matchesFilterClauses returns whether the given result matches all (+and) or any (+or) of the clauses.
+func matchesFilterClauses(operator string, clauses any, result reflect.Value) (bool, error) {
+	list, ok := clauses.([]any)
+	if !ok {
+		return false, fmt.Errorf("%s must be a list", operator)
+	}
+
+	for _, clause := range list {
+		filter, ok := clause.(map[string]any)
+		if !ok {
+			return false, fmt.Errorf("%s must be a list of objects", operator)
+		}
+
+		matched, err := matchesFilter(filter, result)
+		if err != nil {
+			return false, err
+		}
+
+		if matched == (operator == "+or") {
+			return matched, nil
+		}
+	}
+
+	return operator == "+and", nil
+}
+
+// matchesFilterCondition returns whether the value of a field matches the given condition,
+// either a value the field must be equal to or an object of operators and operands.
+func matchesFilterCondition(value, condition any) (bool, error) {
+	operators, ok := condition.(map[string]any)
+	if !ok {
+		return matchesFilterOperator(Eq, value, condition)
+	}
+
+	for operator, operand := range operators {
+		ok, err := matchesFilterOperator(FilterOperator(operator), value, operand)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// filterComparisons are the operators comparing values, and the
+// results of comparing values they match.
+var filterComparisons = map[FilterOperator]func(c int) bool{
+	Eq:  func(c int) bool { return c == 0 },
+	Neq: func(c int) bool { return c != 0 },
+	Gt:  func(c int) bool { return c > 0 },
+	Gte: func(c int) bool { return c >= 0 },
+	Lt:  func(c int) bool { return c < 0 },
+	Lte: func(c int) bool { return c <= 0 },
+}
+
+// matchesFilterOperator returns whether the value of a field matches the given operator and operand.
+// Lists, e.g. tags, match if any of their elements match.
+func matchesFilterOperator(operator FilterOperator, value, operand any) (bool, error) {
+	if list, ok := value.([]any); ok {
+		if operator == Neq {
+			ok, err := matchesFilterOperator(Eq, value, operand)
+			return !ok, err
+		}
+
+		for _, element := range list {
+			ok, err := matchesFilterOperator(operator, element, operand)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+
+		return false, nil
+	}
+
+	if operator == Contains {
+		s, ok := value.(string)
+		substr, isString := operand.(string)
+
+		return ok && isString && strings.Contains(s, substr), nil
+	}
+
+	matches, ok := filterComparisons[operator]
+	if !ok {
+		return false, fmt.Errorf("unknown operator %s", operator)
+	}
+
+	if operator == Eq || operator == Neq {
+		return matches(boolToCompare(reflect.DeepEqual(value, operand))), nil
+	}
+
+	// Only numbers and strings, which include timestamps, can be ordered
+	if !orderableFilterValues(value, operand) {
+		return false, nil
+	}
+
+	return matches(compareFilterValues(value, operand)), nil
+}
+
+func boolToCompare(equal bool) int {
+	if equal {
+		return 0
+	}
+
+	return 1
+}
+
+// filterFieldValue returns the value of the field of the given result with the given name,
+// using dots to separate the names of nested fields (e.g. entity.id), or nil if there is none.
+// Values are normalized to their JSON representation: numbers are float64, timestamps are
+// strings, and lists are []any.
+func filterFieldValue(result reflect.Value, name string) any {
+	value := result
+
+	for _, part := range strings.Split(name, ".") {
+		value = filterField(indirectFilterValue(value), part)
+		if !value.IsValid() {
+			return nil
+		}
+	}
+
+	return normalizeFilterValue(value)
+}
+
+// indirectFilterValue dereferences pointers and interfaces.
+func indirectFilterValue(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+
+		value = value.Elem()
+	}
+
+	return value
+}
+
+// filterField returns the field of the given struct or map with the given name.
+func filterField(value reflect.Value, name string) reflect.Value {
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}
+		}
+
+		return value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+	case reflect.Struct:
+		var excluded reflect.Value
+
+		for i := range value.NumField() {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+			switch {
+			case tag == name:
+				return value.Field(i)
+			case tag == "-":
+				if snakeCase(field.Name) == name {
+					excluded = value.Field(i)
+				}
+			case tag == "" && field.Anonymous:
+				if nested := filterField(indirectFilterValue(value.Field(i)), name); nested.IsValid() {
+					return nested
+				}
+			case tag == "" && field.Name == name:
+				return value.Field(i)
+			}
+		}
+
+		return excluded
+	default:
+		return reflect.Value{}
+	}
+}
+
+// normalizeFilterValue returns the JSON representation of the given value.
+func normalizeFilterValue(value reflect.Value) any {
+	value = indirectFilterValue(value)
+	if !value.IsValid() {
+		return nil
+	}
+
+	if t, ok := value.Interface().(time.Time); ok {
+		return t.UTC().Format(filterTimeFormat)
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.String:
+		return value.String()
+	case reflect.Slice, reflect.Array:
+		list := make([]any, value.Len())
+		for i := range list {
+			list[i] = normalizeFilterValue(value.Index(i))
+		}
+
+		return list
+	default:
+		return value.Interface()
+	}
+}
+
+// snakeCase converts Go names to the snake case used by the API, e.g. LinodeID to linode_id.
+func snakeCase(name string) string {
+	var b strings.Builder
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// orderableFilterValues returns whether the given values are both numbers or both strings.
+func orderableFilterValues(a, b any) bool {
+	_, aNumber := a.(float64)
+	_, bNumber := b.(float64)
+	_, aString := a.(string)
+	_, bString := b.(string)
+
+	return (aNumber && bNumber) || (aString && bString)
+}
+
+// compareFilterValues orders numbers and strings, which include timestamps.
+// Other values are ordered first.
+func compareFilterValues(a, b any) int {
+	switch {
+	case !orderableFilterValues(a, b):
+		switch {
+		case orderableFilterValues(a, a) && !orderableFilterValues(b, b):
+			return 1
+		case !orderableFilterValues(a, a) && orderableFilterValues(b, b):
+			return -1
+		default:
+			return 0
+		}
+	case a == b:
+		return 0
+	}
+
+	if x, ok := a.(float64); ok {
+		if x < b.(float64) {
+			return -1
+		}
+
+		return 1
+	}
+
+	return strings.Compare(a.(string), b.(string))
+}
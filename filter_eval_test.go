@@ -0,0 +1,122 @@
+package linodego
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Matches(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	instance := Instance{
+		ID:      123,
+		Label:   "web-1",
+		Region:  "us-east",
+		Status:  InstanceRunning,
+		Tags:    []string{"prod", "web"},
+		Created: &created,
+		Specs:   &InstanceSpec{Disk: 25600, Memory: 1024},
+	}
+
+	for expr, expected := range map[string]bool{
+		`region == "us-east"`: true,
+		`region != "us-east"`: false,
+		`region == "us-east" && (label ~ "db" || tags ~ "prod")`:  true,
+		`region == "us-east" && (label ~ "db" || tags ~ "stage")`: false,
+		`tags == "web"`:         true,
+		`tags != "web"`:         false,
+		`label ~ "WEB"`:         false,
+		`id > 100 && id <= 123`: true,
+		`id > 123`:              false,
+		`specs.memory >= 1024 && specs.disk < 30000`: true,
+		`status == "running"`:                        true,
+		`created >= "2024-01-02T03:04:05"`:           true,
+		`created > "2024-01-02T03:04:05"`:            false,
+		`image == "linode/debian12"`:                 false,
+		`missing.field ~ "x"`:                        false,
+	} {
+		filter, err := ParseFilter(expr)
+		require.NoError(t, err)
+
+		ok, err := filter.Matches(instance)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok, expr)
+
+		// Pointers are matched as the values they point to
+		ok, err = filter.Matches(&instance)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok, expr)
+	}
+
+	filter := Filter{}
+	filter.Add(EventFilter.Created().Lt(created.Add(time.Second)))
+
+	ok, err := filter.Matches(Event{Created: &created})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = filter.Matches(map[string]any{"created": "2024-01-02T03:04:06"})
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestFilterResults(t *testing.T) {
+	volumes := []Volume{
+		{ID: 1, Label: "b", Size: 20, Tags: []string{"prod"}},
+		{ID: 2, Label: "a", Size: 40, Tags: []string{"prod"}},
+		{ID: 3, Label: "c", Size: 10},
+		{ID: 4, Label: "d", Size: 40, Tags: []string{"prod"}},
+	}
+
+	filter, err := ParseFilter(`tags == "prod"`)
+	require.NoError(t, err)
+
+	filter.OrderBy = "size"
+	filter.Order = Descending
+
+	result, err := FilterResults(filter, volumes)
+	require.NoError(t, err)
+	require.Equal(t, []Volume{volumes[1], volumes[3], volumes[0]}, result)
+
+	filter.OrderBy = "label"
+	filter.Order = Ascending
+
+	result, err = FilterResults(filter, volumes)
+	require.NoError(t, err)
+	require.Equal(t, []Volume{volumes[1], volumes[0], volumes[3]}, result)
+
+	_, err = FilterResults(&Filter{Children: []FilterNode{&Comp{"size", "+like", 10}}}, volumes)
+	require.ErrorContains(t, err, "unknown operator +like")
+}
+
+func TestFilter_UnmarshalJSON(t *testing.T) {
+	for _, filter := range []string{
+		`{"region":"us-east"}`,
+		`{"+order_by":"label","+order":"desc","+or":[{"label":{"+contains":"web"}},{"tags":"prod"}]}`,
+		`{"+and":[{"region":"us-east"},{"+or":[{"id":{"+gt":1}},{"id":{"+lt":-1}}]}]}`,
+		`{"+and":[{"id":{"+gte":1}},{"id":{"+lt":5}}]}`,
+	} {
+		var result Filter
+		require.NoError(t, json.Unmarshal([]byte(filter), &result))
+
+		b, err := result.MarshalJSON()
+		require.NoError(t, err)
+		require.JSONEq(t, filter, string(b))
+	}
+
+	// Fields with multiple operators must match all of them
+	var result Filter
+	require.NoError(t, json.Unmarshal([]byte(`{"id":{"+gte":1,"+lt":5},"+order_by":"id"}`), &result))
+	require.Equal(t, "id", result.OrderBy)
+
+	ok, err := result.Matches(Volume{ID: 5})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.ErrorContains(t, json.Unmarshal([]byte(`{"label":{"+like":"web"}}`), &result), "unknown operator +like")
+	require.ErrorContains(t, json.Unmarshal([]byte(`{"+not":[]}`), &result), "unknown operator +not")
+	require.ErrorContains(t, json.Unmarshal([]byte(`{"+or":{}}`), &result), "+or must be a list")
+}
@@ -0,0 +1,114 @@
+package linodego
+
+import (
+	"time"
+)
+
+//go:generate go run ./internal/filtergen -output filter_resources.go
+
+// filterTimeFormat is the format of timestamps compared by filters.
+const filterTimeFormat = "2006-01-02T15:04:05"
+
+// FilterField is a field of a resource that results can be filtered by, used to
+// build comparisons whose values are checked at compile time, e.g.
+// InstanceFilter.Status().Eq(InstanceRunning).
+// The filterable fields of resources are provided by variables such as InstanceFilter.
+type FilterField[T any] struct {
+	column string
+}
+
+// Column returns the name of the field in filters.
+func (f FilterField[T]) Column() string {
+	return f.column
+}
+
+// Eq matches results where the field equals the given value.
+// List fields match if any of their elements equals the given value.
+func (f FilterField[T]) Eq(value T) *Comp {
+	return &Comp{f.column, Eq, value}
+}
+
+// Neq matches results where the field does not equal the given value.
+func (f FilterField[T]) Neq(value T) *Comp {
+	return &Comp{f.column, Neq, value}
+}
+
+// StringFilterField is a string field of a resource that results can be filtered by.
+type StringFilterField[T ~string] struct {
+	FilterField[T]
+}
+
+// Contains matches results where the field contains the given value.
+func (f StringFilterField[T]) Contains(value T) *Comp {
+	return &Comp{f.column, Contains, value}
+}
+
+// NumberFilterField is a numeric field of a resource that results can be filtered by.
+type NumberFilterField[T ~int | ~float64] struct {
+	FilterField[T]
+}
+
+// Gt matches results where the field is greater than the given value.
+func (f NumberFilterField[T]) Gt(value T) *Comp {
+	return &Comp{f.column, Gt, value}
+}
+
+// Gte matches results where the field is greater than or equal to the given value.
+func (f NumberFilterField[T]) Gte(value T) *Comp {
+	return &Comp{f.column, Gte, value}
+}
+
+// Lt matches results where the field is less than the given value.
+func (f NumberFilterField[T]) Lt(value T) *Comp {
+	return &Comp{f.column, Lt, value}
+}
+
+// Lte matches results where the field is less than or equal to the given value.
+func (f NumberFilterField[T]) Lte(value T) *Comp {
+	return &Comp{f.column, Lte, value}
+}
+
+// TimeFilterField is a timestamp field of a resource that results can be filtered by.
+// Times are compared in UTC with a precision of one second.
+type TimeFilterField struct {
+	column string
+}
+
+// Column returns the name of the field in filters.
+func (f TimeFilterField) Column() string {
+	return f.column
+}
+
+// Eq matches results where the field equals the given time.
+func (f TimeFilterField) Eq(t time.Time) *Comp {
+	return f.compare(Eq, t)
+}
+
+// Neq matches results where the field does not equal the given time.
+func (f TimeFilterField) Neq(t time.Time) *Comp {
+	return f.compare(Neq, t)
+}
+
+// Gt matches results where the field is after the given time.
+func (f TimeFilterField) Gt(t time.Time) *Comp {
+	return f.compare(Gt, t)
+}
+
+// Gte matches results where the field is at or after the given time.
+func (f TimeFilterField) Gte(t time.Time) *Comp {
+	return f.compare(Gte, t)
+}
+
+// Lt matches results where the field is before the given time.
+func (f TimeFilterField) Lt(t time.Time) *Comp {
+	return f.compare(Lt, t)
+}
+
+// Lte matches results where the field is at or before the given time.
+func (f TimeFilterField) Lte(t time.Time) *Comp {
+	return f.compare(Lte, t)
+}
+
+func (f TimeFilterField) compare(op FilterOperator, t time.Time) *Comp {
+	return &Comp{f.column, op, t.UTC().Format(filterTimeFormat)}
+}
@@ -0,0 +1,311 @@
+package linodego
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseFilter parses a filter expression, such as one entered by a user, into a Filter.
+//
+// Expressions compare fields to values, using dots to separate the names of nested
+// fields (e.g. entity.id), and combine comparisons using && and ||, with && taking
+// precedence, and parentheses:
+//
+//	region == "us-east" && (label ~ "web" || tags ~ "prod")
+//
+// The supported operators are == (Eq), != (Neq), > (Gt), >= (Gte), < (Lt), <= (Lte)
+// and ~ (Contains). Values are double or back quoted strings using Go syntax,
+// numbers, true or false.
+func ParseFilter(expr string) (*Filter, error) {
+	p := &filterParser{lexer: filterLexer{input: expr}}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.token.kind != filterTokenEOF {
+		return nil, p.errorf("unexpected %s", p.token)
+	}
+
+	if filter, ok := node.(*Filter); ok {
+		return filter, nil
+	}
+
+	return &Filter{Children: []FilterNode{node}}, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEOF filterTokenKind = iota
+	filterTokenField
+	filterTokenValue
+	filterTokenOperator
+	filterTokenAnd
+	filterTokenOr
+	filterTokenOpen
+	filterTokenClose
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+
+	// value is the value of value tokens
+	value any
+}
+
+func (t filterToken) String() string {
+	if t.kind == filterTokenEOF {
+		return "end of expression"
+	}
+
+	return strconv.Quote(t.text)
+}
+
+var filterOperators = map[string]FilterOperator{
+	"==": Eq,
+	"!=": Neq,
+	">":  Gt,
+	">=": Gte,
+	"<":  Lt,
+	"<=": Lte,
+	"~":  Contains,
+}
+
+// filterLexer splits filter expressions into tokens.
+type filterLexer struct {
+	input string
+	pos   int
+}
+
+func (l *filterLexer) next() (filterToken, error) {
+	for l.pos < len(l.input) && unicode.IsSpace(rune(l.input[l.pos])) {
+		l.pos++
+	}
+
+	start := l.pos
+	token := func(kind filterTokenKind, length int) filterToken {
+		l.pos += length
+		return filterToken{kind: kind, text: l.input[start:l.pos], pos: start}
+	}
+
+	if l.pos >= len(l.input) {
+		return filterToken{kind: filterTokenEOF, pos: start}, nil
+	}
+
+	rest := l.input[l.pos:]
+
+	switch {
+	case strings.HasPrefix(rest, "&&"):
+		return token(filterTokenAnd, 2), nil
+	case strings.HasPrefix(rest, "||"):
+		return token(filterTokenOr, 2), nil
+	case rest[0] == '(':
+		return token(filterTokenOpen, 1), nil
+	case rest[0] == ')':
+		return token(filterTokenClose, 1), nil
+	case strings.ContainsRune("=!<>~", rune(rest[0])):
+		if len(rest) > 1 && rest[1] == '=' {
+			if _, ok := filterOperators[rest[:2]]; ok {
+				return token(filterTokenOperator, 2), nil
+			}
+		}
+
+		if _, ok := filterOperators[rest[:1]]; ok {
+			return token(filterTokenOperator, 1), nil
+		}
+
+		return filterToken{}, fmt.Errorf("invalid filter expression at position %d: unknown operator %q", start, rest[:1])
+	case rest[0] == '"' || rest[0] == '`':
+		return l.string()
+	}
+
+	// Fields, numbers and booleans
+	for l.pos < len(l.input) && isFilterWordChar(rune(l.input[l.pos])) {
+		l.pos++
+	}
+
+	if l.pos == start {
+		return filterToken{}, fmt.Errorf("invalid filter expression at position %d: unexpected character %q", start, rest[0])
+	}
+
+	word := l.input[start:l.pos]
+	result := filterToken{kind: filterTokenValue, text: word, pos: start}
+
+	switch {
+	case word == "true" || word == "false":
+		result.value = word == "true"
+	case strings.ContainsAny(word[:1], "-0123456789"):
+		if i, err := strconv.Atoi(word); err == nil {
+			result.value = i
+		} else if f, err := strconv.ParseFloat(word, 64); err == nil {
+			result.value = f
+		} else {
+			return filterToken{}, fmt.Errorf("invalid filter expression at position %d: invalid number %q", start, word)
+		}
+	default:
+		result.kind = filterTokenField
+	}
+
+	return result, nil
+}
+
+// string lexes a double or back quoted string.
+func (l *filterLexer) string() (filterToken, error) {
+	start := l.pos
+	quote := l.input[start]
+
+	for l.pos++; l.pos < len(l.input); l.pos++ {
+		switch l.input[l.pos] {
+		case '\\':
+			if quote == '"' {
+				l.pos++
+			}
+		case quote:
+			l.pos++
+
+			text := l.input[start:l.pos]
+
+			value, err := strconv.Unquote(text)
+			if err != nil {
+				return filterToken{}, fmt.Errorf("invalid filter expression at position %d: invalid string %s", start, text)
+			}
+
+			return filterToken{kind: filterTokenValue, text: text, pos: start, value: value}, nil
+		}
+	}
+
+	return filterToken{}, fmt.Errorf("invalid filter expression at position %d: unterminated string", start)
+}
+
+func isFilterWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.-+", r)
+}
+
+// filterParser parses filter expressions using recursive descent.
+type filterParser struct {
+	lexer filterLexer
+	token filterToken
+}
+
+func (p *filterParser) next() (err error) {
+	p.token, err = p.lexer.next()
+	return err
+}
+
+func (p *filterParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid filter expression at position %d: %s", p.token.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *filterParser) parseOr() (FilterNode, error) {
+	return p.parseLogical(filterTokenOr, "+or", p.parseAnd)
+}
+
+func (p *filterParser) parseAnd() (FilterNode, error) {
+	return p.parseLogical(filterTokenAnd, "+and", p.parseOperand)
+}
+
+// parseLogical parses operands separated by the given logical operator,
+// combining them into a Filter if there is more than one.
+func (p *filterParser) parseLogical(
+	kind filterTokenKind,
+	operator string,
+	parseOperand func() (FilterNode, error),
+) (FilterNode, error) {
+	node, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.token.kind != kind {
+		return node, nil
+	}
+
+	filter := &Filter{Operator: operator}
+	filter.Add(flattenFilterNode(node, operator)...)
+
+	for p.token.kind == kind {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		node, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Add(flattenFilterNode(node, operator)...)
+	}
+
+	return filter, nil
+}
+
+// flattenFilterNode returns the children of filters using the given operator,
+// so that e.g. a && (b && c) results in a single filter.
+func flattenFilterNode(node FilterNode, operator string) []FilterNode {
+	if filter, ok := node.(*Filter); ok && filter.Operator == operator {
+		return filter.Children
+	}
+
+	return []FilterNode{node}
+}
+
+func (p *filterParser) parseOperand() (FilterNode, error) {
+	if p.token.kind == filterTokenOpen {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.token.kind != filterTokenClose {
+			return nil, p.errorf("expected \")\", found %s", p.token)
+		}
+
+		return node, p.next()
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (FilterNode, error) {
+	if p.token.kind != filterTokenField {
+		return nil, p.errorf("expected field, found %s", p.token)
+	}
+
+	column := p.token.text
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind != filterTokenOperator {
+		return nil, p.errorf("expected operator, found %s", p.token)
+	}
+
+	operator := filterOperators[p.token.text]
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind != filterTokenValue {
+		return nil, p.errorf("expected value, found %s", p.token)
+	}
+
+	value := p.token.value
+
+	return &Comp{column, operator, value}, p.next()
+}
@@ -0,0 +1,46 @@
+package linodego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	for expr, expected := range map[string]string{
+		`region == "us-east"`: `{"region":"us-east"}`,
+		`region == "us-east" && (label ~ "web" || tags ~ "prod")`: `{"+and":[{"region":"us-east"},` +
+			`{"+or":[{"label":{"+contains":"web"}},{"tags":{"+contains":"prod"}}]}]}`,
+		`a == 1 || b == 2 && c == 3`:                `{"+or":[{"a":1},{"+and":[{"b":2},{"c":3}]}]}`,
+		`(a == 1 || b == 2) && c == 3`:              `{"+and":[{"+or":[{"a":1},{"b":2}]},{"c":3}]}`,
+		`a == 1 && (b == 2 && c == 3)`:              `{"+and":[{"a":1},{"b":2},{"c":3}]}`,
+		`id > 10 && id <= 20.5`:                     `{"+and":[{"id":{"+gt":10}},{"id":{"+lte":20.5}}]}`,
+		`entity.id >= -1 && seen != false`:          `{"+and":[{"entity.id":{"+gte":-1}},{"seen":{"+neq":false}}]}`,
+		"label < `say \"hi\"` && label==\"tab\\t\"": `{"+and":[{"label":{"+lt":"say \"hi\""}},{"label":"tab\t"}]}`,
+	} {
+		filter, err := ParseFilter(expr)
+		require.NoError(t, err, expr)
+
+		result, err := filter.MarshalJSON()
+		require.NoError(t, err)
+		require.JSONEq(t, expected, string(result), expr)
+	}
+}
+
+func TestParseFilter_errors(t *testing.T) {
+	for expr, expected := range map[string]string{
+		``:                        "position 0: expected field, found end of expression",
+		`region`:                  "position 6: expected operator, found end of expression",
+		`region = "us-east"`:      `position 7: unknown operator "="`,
+		`region == us-east`:       `position 10: expected value, found "us-east"`,
+		`region == "us-east`:      "position 10: unterminated string",
+		`(a == 1 || b == 2`:       `position 17: expected ")", found end of expression`,
+		`a == 1 b == 2`:           `position 7: unexpected "b"`,
+		`a == 1 && && b == 2`:     `position 10: expected field, found "&&"`,
+		`a == 1.2.3`:              `position 5: invalid number "1.2.3"`,
+		`region == "us-east" & x`: `position 20: unexpected character '&'`,
+	} {
+		_, err := ParseFilter(expr)
+		require.ErrorContains(t, err, expected, expr)
+	}
+}
@@ -0,0 +1,445 @@
+// Code generated by internal/filtergen. DO NOT EDIT.
+
+package linodego
+
+// DomainFilter provides the fields domains can be filtered by.
+var DomainFilter DomainFilterFields
+
+// DomainFilterFields are the fields domains can be filtered by.
+type DomainFilterFields struct{}
+
+// ID filters domains by their ID.
+func (DomainFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// Domain filters domains by their domain name.
+func (DomainFilterFields) Domain() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"domain"}}
+}
+
+// Group filters domains by their group.
+func (DomainFilterFields) Group() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"group"}}
+}
+
+// Status filters domains by their status.
+func (DomainFilterFields) Status() StringFilterField[DomainStatus] {
+	return StringFilterField[DomainStatus]{FilterField[DomainStatus]{"status"}}
+}
+
+// Tags filters domains by their tags.
+func (DomainFilterFields) Tags() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"tags"}}
+}
+
+// Type filters domains by their type.
+func (DomainFilterFields) Type() StringFilterField[DomainType] {
+	return StringFilterField[DomainType]{FilterField[DomainType]{"type"}}
+}
+
+// EventFilter provides the fields events can be filtered by.
+var EventFilter EventFilterFields
+
+// EventFilterFields are the fields events can be filtered by.
+type EventFilterFields struct{}
+
+// ID filters events by their ID.
+func (EventFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// Action filters events by their action.
+func (EventFilterFields) Action() StringFilterField[EventAction] {
+	return StringFilterField[EventAction]{FilterField[EventAction]{"action"}}
+}
+
+// Created filters events by when they were created.
+func (EventFilterFields) Created() TimeFilterField {
+	return TimeFilterField{"created"}
+}
+
+// EntityID filters events by the ID of their entity.
+func (EventFilterFields) EntityID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"entity.id"}}
+}
+
+// EntityType filters events by the type of their entity.
+func (EventFilterFields) EntityType() StringFilterField[EntityType] {
+	return StringFilterField[EntityType]{FilterField[EntityType]{"entity.type"}}
+}
+
+// Seen filters events by whether they have been seen.
+func (EventFilterFields) Seen() FilterField[bool] {
+	return FilterField[bool]{"seen"}
+}
+
+// FirewallFilter provides the fields firewalls can be filtered by.
+var FirewallFilter FirewallFilterFields
+
+// FirewallFilterFields are the fields firewalls can be filtered by.
+type FirewallFilterFields struct{}
+
+// ID filters firewalls by their ID.
+func (FirewallFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// Label filters firewalls by their label.
+func (FirewallFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Status filters firewalls by their status.
+func (FirewallFilterFields) Status() StringFilterField[FirewallStatus] {
+	return StringFilterField[FirewallStatus]{FilterField[FirewallStatus]{"status"}}
+}
+
+// Tags filters firewalls by their tags.
+func (FirewallFilterFields) Tags() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"tags"}}
+}
+
+// ImageFilter provides the fields images can be filtered by.
+var ImageFilter ImageFilterFields
+
+// ImageFilterFields are the fields images can be filtered by.
+type ImageFilterFields struct{}
+
+// ID filters images by their ID.
+func (ImageFilterFields) ID() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"id"}}
+}
+
+// CreatedBy filters images by the user who created them.
+func (ImageFilterFields) CreatedBy() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"created_by"}}
+}
+
+// Deprecated filters images by whether they are deprecated.
+func (ImageFilterFields) Deprecated() FilterField[bool] {
+	return FilterField[bool]{"deprecated"}
+}
+
+// IsPublic filters images by whether they are public.
+func (ImageFilterFields) IsPublic() FilterField[bool] {
+	return FilterField[bool]{"is_public"}
+}
+
+// Label filters images by their label.
+func (ImageFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Size filters images by their size in MB.
+func (ImageFilterFields) Size() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"size"}}
+}
+
+// Status filters images by their status.
+func (ImageFilterFields) Status() StringFilterField[ImageStatus] {
+	return StringFilterField[ImageStatus]{FilterField[ImageStatus]{"status"}}
+}
+
+// Type filters images by their type.
+func (ImageFilterFields) Type() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"type"}}
+}
+
+// Vendor filters images by their vendor.
+func (ImageFilterFields) Vendor() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"vendor"}}
+}
+
+// InstanceFilter provides the fields instances can be filtered by.
+var InstanceFilter InstanceFilterFields
+
+// InstanceFilterFields are the fields instances can be filtered by.
+type InstanceFilterFields struct{}
+
+// ID filters instances by their ID.
+func (InstanceFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// Group filters instances by their group.
+func (InstanceFilterFields) Group() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"group"}}
+}
+
+// Image filters instances by the image they were deployed from.
+func (InstanceFilterFields) Image() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"image"}}
+}
+
+// Label filters instances by their label.
+func (InstanceFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Region filters instances by their region.
+func (InstanceFilterFields) Region() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"region"}}
+}
+
+// Status filters instances by their status.
+func (InstanceFilterFields) Status() StringFilterField[InstanceStatus] {
+	return StringFilterField[InstanceStatus]{FilterField[InstanceStatus]{"status"}}
+}
+
+// Tags filters instances by their tags.
+func (InstanceFilterFields) Tags() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"tags"}}
+}
+
+// Type filters instances by their type.
+func (InstanceFilterFields) Type() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"type"}}
+}
+
+// KernelFilter provides the fields kernels can be filtered by.
+var KernelFilter KernelFilterFields
+
+// KernelFilterFields are the fields kernels can be filtered by.
+type KernelFilterFields struct{}
+
+// ID filters kernels by their ID.
+func (KernelFilterFields) ID() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"id"}}
+}
+
+// Architecture filters kernels by their architecture.
+func (KernelFilterFields) Architecture() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"architecture"}}
+}
+
+// Deprecated filters kernels by whether they are deprecated.
+func (KernelFilterFields) Deprecated() FilterField[bool] {
+	return FilterField[bool]{"deprecated"}
+}
+
+// KVM filters kernels by whether they are compatible with KVM.
+func (KernelFilterFields) KVM() FilterField[bool] {
+	return FilterField[bool]{"kvm"}
+}
+
+// Label filters kernels by their label.
+func (KernelFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// PVOPS filters kernels by whether they are compatible with paravirt-ops.
+func (KernelFilterFields) PVOPS() FilterField[bool] {
+	return FilterField[bool]{"pvops"}
+}
+
+// Version filters kernels by their version.
+func (KernelFilterFields) Version() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"version"}}
+}
+
+// LinodeTypeFilter provides the fields instance types can be filtered by.
+var LinodeTypeFilter LinodeTypeFilterFields
+
+// LinodeTypeFilterFields are the fields instance types can be filtered by.
+type LinodeTypeFilterFields struct{}
+
+// ID filters instance types by their ID.
+func (LinodeTypeFilterFields) ID() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"id"}}
+}
+
+// Class filters instance types by their class.
+func (LinodeTypeFilterFields) Class() StringFilterField[LinodeTypeClass] {
+	return StringFilterField[LinodeTypeClass]{FilterField[LinodeTypeClass]{"class"}}
+}
+
+// Disk filters instance types by their disk size in MB.
+func (LinodeTypeFilterFields) Disk() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"disk"}}
+}
+
+// GPUs filters instance types by their number of GPUs.
+func (LinodeTypeFilterFields) GPUs() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"gpus"}}
+}
+
+// Label filters instance types by their label.
+func (LinodeTypeFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Memory filters instance types by their memory in MB.
+func (LinodeTypeFilterFields) Memory() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"memory"}}
+}
+
+// VCPUs filters instance types by their number of vCPUs.
+func (LinodeTypeFilterFields) VCPUs() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"vcpus"}}
+}
+
+// LKEClusterFilter provides the fields LKE clusters can be filtered by.
+var LKEClusterFilter LKEClusterFilterFields
+
+// LKEClusterFilterFields are the fields LKE clusters can be filtered by.
+type LKEClusterFilterFields struct{}
+
+// ID filters LKE clusters by their ID.
+func (LKEClusterFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// K8sVersion filters LKE clusters by their Kubernetes version.
+func (LKEClusterFilterFields) K8sVersion() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"k8s_version"}}
+}
+
+// Label filters LKE clusters by their label.
+func (LKEClusterFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Region filters LKE clusters by their region.
+func (LKEClusterFilterFields) Region() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"region"}}
+}
+
+// Tags filters LKE clusters by their tags.
+func (LKEClusterFilterFields) Tags() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"tags"}}
+}
+
+// NodeBalancerFilter provides the fields NodeBalancers can be filtered by.
+var NodeBalancerFilter NodeBalancerFilterFields
+
+// NodeBalancerFilterFields are the fields NodeBalancers can be filtered by.
+type NodeBalancerFilterFields struct{}
+
+// ID filters NodeBalancers by their ID.
+func (NodeBalancerFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// IPv4 filters NodeBalancers by their IPv4 address.
+func (NodeBalancerFilterFields) IPv4() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"ipv4"}}
+}
+
+// Label filters NodeBalancers by their label.
+func (NodeBalancerFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Region filters NodeBalancers by their region.
+func (NodeBalancerFilterFields) Region() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"region"}}
+}
+
+// Tags filters NodeBalancers by their tags.
+func (NodeBalancerFilterFields) Tags() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"tags"}}
+}
+
+// StackscriptFilter provides the fields StackScripts can be filtered by.
+var StackscriptFilter StackscriptFilterFields
+
+// StackscriptFilterFields are the fields StackScripts can be filtered by.
+type StackscriptFilterFields struct{}
+
+// ID filters StackScripts by their ID.
+func (StackscriptFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// DeploymentsTotal filters StackScripts by their total number of deployments.
+func (StackscriptFilterFields) DeploymentsTotal() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"deployments_total"}}
+}
+
+// Description filters StackScripts by their description.
+func (StackscriptFilterFields) Description() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"description"}}
+}
+
+// IsPublic filters StackScripts by whether they are public.
+func (StackscriptFilterFields) IsPublic() FilterField[bool] {
+	return FilterField[bool]{"is_public"}
+}
+
+// Label filters StackScripts by their label.
+func (StackscriptFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Mine filters StackScripts by whether they are owned by the current user.
+func (StackscriptFilterFields) Mine() FilterField[bool] {
+	return FilterField[bool]{"mine"}
+}
+
+// Username filters StackScripts by the user who created them.
+func (StackscriptFilterFields) Username() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"username"}}
+}
+
+// VolumeFilter provides the fields volumes can be filtered by.
+var VolumeFilter VolumeFilterFields
+
+// VolumeFilterFields are the fields volumes can be filtered by.
+type VolumeFilterFields struct{}
+
+// ID filters volumes by their ID.
+func (VolumeFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// Label filters volumes by their label.
+func (VolumeFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// LinodeID filters volumes by the ID of the instance they are attached to.
+func (VolumeFilterFields) LinodeID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"linode_id"}}
+}
+
+// Region filters volumes by their region.
+func (VolumeFilterFields) Region() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"region"}}
+}
+
+// Size filters volumes by their size in GB.
+func (VolumeFilterFields) Size() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"size"}}
+}
+
+// Status filters volumes by their status.
+func (VolumeFilterFields) Status() StringFilterField[VolumeStatus] {
+	return StringFilterField[VolumeStatus]{FilterField[VolumeStatus]{"status"}}
+}
+
+// Tags filters volumes by their tags.
+func (VolumeFilterFields) Tags() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"tags"}}
+}
+
+// VPCFilter provides the fields VPCs can be filtered by.
+var VPCFilter VPCFilterFields
+
+// VPCFilterFields are the fields VPCs can be filtered by.
+type VPCFilterFields struct{}
+
+// ID filters VPCs by their ID.
+func (VPCFilterFields) ID() NumberFilterField[int] {
+	return NumberFilterField[int]{FilterField[int]{"id"}}
+}
+
+// Label filters VPCs by their label.
+func (VPCFilterFields) Label() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"label"}}
+}
+
+// Region filters VPCs by their region.
+func (VPCFilterFields) Region() StringFilterField[string] {
+	return StringFilterField[string]{FilterField[string]{"region"}}
+}
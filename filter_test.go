@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestFilter(t *testing.T) {
@@ -162,3 +163,85 @@ func TestFilterOr(t *testing.T) {
 		t.Fatal(string(result), " doesn't match ", string(expectedStr))
 	}
 }
+
+func TestFilterNested(t *testing.T) {
+	expected := map[string]any{
+		"+order_by": "label",
+		"+and": []map[string]any{
+			{
+				"+or": []map[string]any{
+					{"region": "us-east"},
+					{"region": "us-west"},
+				},
+			},
+			{
+				"tags": map[string]any{
+					"+contains": "prod",
+				},
+			},
+		},
+	}
+
+	expectedStr, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatalf("failed to marshal expected json: %v", err)
+	}
+
+	f := Filter{OrderBy: "label"}
+	f.Add(
+		Or("", "",
+			InstanceFilter.Region().Eq("us-east"),
+			InstanceFilter.Region().Eq("us-west"),
+		),
+		InstanceFilter.Tags().Contains("prod"),
+	)
+
+	result, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal filter: %v", err)
+	}
+
+	if !reflect.DeepEqual(result, expectedStr) {
+		t.Fatal(string(result), " doesn't match ", string(expectedStr))
+	}
+}
+
+func TestFilterFields(t *testing.T) {
+	expected := map[string]any{
+		"+or": []map[string]any{
+			{
+				"+and": []map[string]any{
+					{"action": "linode_boot"},
+					{"created": map[string]any{"+gte": "2024-01-02T03:04:05"}},
+				},
+			},
+			{
+				"entity.id": map[string]any{"+lt": 123},
+			},
+		},
+	}
+
+	expectedStr, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatalf("failed to marshal expected json: %v", err)
+	}
+
+	created := time.Date(2024, 1, 2, 4, 4, 5, 0, time.FixedZone("CET", 3600))
+
+	out := Or("", "",
+		And("", "",
+			EventFilter.Action().Eq(ActionLinodeBoot),
+			EventFilter.Created().Gte(created),
+		),
+		EventFilter.EntityID().Lt(123),
+	)
+
+	result, err := out.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal filter: %v", err)
+	}
+
+	if !reflect.DeepEqual(result, expectedStr) {
+		t.Fatal(string(result), " doesn't match ", string(expectedStr))
+	}
+}
@@ -290,6 +290,8 @@ func (c *Client) GetInstanceTransfer(ctx context.Context, linodeID int) (*Instan
 
 // CreateInstance creates a Linode instance
 func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions) (*Instance, error) {
+	c.applyProfileDefaults(&opts)
+
 	e := "linode/instances"
 	response, err := doPOSTRequest[Instance](ctx, c, e, opts)
 	if err != nil {
@@ -380,6 +382,8 @@ type InstanceRebuildOptions struct {
 // RebuildInstance Deletes all Disks and Configs on this Linode,
 // then deploys a new Image to this Linode with the given attributes.
 func (c *Client) RebuildInstance(ctx context.Context, linodeID int, opts InstanceRebuildOptions) (*Instance, error) {
+	c.applyProfileDefaults(&opts)
+
 	e := formatAPIPath("linode/instances/%d/rebuild", linodeID)
 	response, err := doPOSTRequest[Instance](ctx, c, e, opts)
 	if err != nil {
@@ -3,7 +3,6 @@ package duration
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 )
@@ -33,8 +32,7 @@ func UnmarshalTimeRemaining(m json.RawMessage) *int {
 		return &intPtr
 	}
 
-	log.Println("[WARN] Unexpected unmarshalTimeRemaining value: ", jsonBytes)
-
+	// Unexpected values are treated as unknown
 	return nil
 }
 
@@ -0,0 +1,262 @@
+// Command filtergen generates the typed filter fields of resources, such as
+// InstanceFilter, from the filterable fields listed below.
+//
+// Usage: go run ./internal/filtergen -output filter_resources.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+)
+
+// field is a field of a resource that results can be filtered by.
+type field struct {
+	// Method is the name of the method returning the field.
+	Method string
+
+	// Column is the name of the field in filters.
+	Column string
+
+	// Kind is the kind of the field: string, int, float64, bool or time.
+	Kind string
+
+	// Type is the Go type of values the field is compared to, if not the kind,
+	// e.g. InstanceStatus. Only used for string fields.
+	Type string
+
+	// Doc describes the field, completing "filters <resources> by ...".
+	Doc string
+}
+
+// resource is an API resource whose list endpoint supports filtering.
+type resource struct {
+	// Name is the Go name of the resource, e.g. Instance.
+	Name string
+
+	// Plural describes results of the resource in documentation.
+	Plural string
+
+	Fields []field
+}
+
+var resources = []resource{
+	{
+		Name:   "Domain",
+		Plural: "domains",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "Domain", Column: "domain", Kind: "string", Doc: "their domain name"},
+			{Method: "Group", Column: "group", Kind: "string", Doc: "their group"},
+			{Method: "Status", Column: "status", Kind: "string", Type: "DomainStatus", Doc: "their status"},
+			{Method: "Tags", Column: "tags", Kind: "string", Doc: "their tags"},
+			{Method: "Type", Column: "type", Kind: "string", Type: "DomainType", Doc: "their type"},
+		},
+	},
+	{
+		Name:   "Event",
+		Plural: "events",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "Action", Column: "action", Kind: "string", Type: "EventAction", Doc: "their action"},
+			{Method: "Created", Column: "created", Kind: "time", Doc: "when they were created"},
+			{Method: "EntityID", Column: "entity.id", Kind: "int", Doc: "the ID of their entity"},
+			{Method: "EntityType", Column: "entity.type", Kind: "string", Type: "EntityType", Doc: "the type of their entity"},
+			{Method: "Seen", Column: "seen", Kind: "bool", Doc: "whether they have been seen"},
+		},
+	},
+	{
+		Name:   "Firewall",
+		Plural: "firewalls",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Status", Column: "status", Kind: "string", Type: "FirewallStatus", Doc: "their status"},
+			{Method: "Tags", Column: "tags", Kind: "string", Doc: "their tags"},
+		},
+	},
+	{
+		Name:   "Image",
+		Plural: "images",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "string", Doc: "their ID"},
+			{Method: "CreatedBy", Column: "created_by", Kind: "string", Doc: "the user who created them"},
+			{Method: "Deprecated", Column: "deprecated", Kind: "bool", Doc: "whether they are deprecated"},
+			{Method: "IsPublic", Column: "is_public", Kind: "bool", Doc: "whether they are public"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Size", Column: "size", Kind: "int", Doc: "their size in MB"},
+			{Method: "Status", Column: "status", Kind: "string", Type: "ImageStatus", Doc: "their status"},
+			{Method: "Type", Column: "type", Kind: "string", Doc: "their type"},
+			{Method: "Vendor", Column: "vendor", Kind: "string", Doc: "their vendor"},
+		},
+	},
+	{
+		Name:   "Instance",
+		Plural: "instances",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "Group", Column: "group", Kind: "string", Doc: "their group"},
+			{Method: "Image", Column: "image", Kind: "string", Doc: "the image they were deployed from"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Region", Column: "region", Kind: "string", Doc: "their region"},
+			{Method: "Status", Column: "status", Kind: "string", Type: "InstanceStatus", Doc: "their status"},
+			{Method: "Tags", Column: "tags", Kind: "string", Doc: "their tags"},
+			{Method: "Type", Column: "type", Kind: "string", Doc: "their type"},
+		},
+	},
+	{
+		Name:   "Kernel",
+		Plural: "kernels",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "string", Doc: "their ID"},
+			{Method: "Architecture", Column: "architecture", Kind: "string", Doc: "their architecture"},
+			{Method: "Deprecated", Column: "deprecated", Kind: "bool", Doc: "whether they are deprecated"},
+			{Method: "KVM", Column: "kvm", Kind: "bool", Doc: "whether they are compatible with KVM"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "PVOPS", Column: "pvops", Kind: "bool", Doc: "whether they are compatible with paravirt-ops"},
+			{Method: "Version", Column: "version", Kind: "string", Doc: "their version"},
+		},
+	},
+	{
+		Name:   "LinodeType",
+		Plural: "instance types",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "string", Doc: "their ID"},
+			{Method: "Class", Column: "class", Kind: "string", Type: "LinodeTypeClass", Doc: "their class"},
+			{Method: "Disk", Column: "disk", Kind: "int", Doc: "their disk size in MB"},
+			{Method: "GPUs", Column: "gpus", Kind: "int", Doc: "their number of GPUs"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Memory", Column: "memory", Kind: "int", Doc: "their memory in MB"},
+			{Method: "VCPUs", Column: "vcpus", Kind: "int", Doc: "their number of vCPUs"},
+		},
+	},
+	{
+		Name:   "LKECluster",
+		Plural: "LKE clusters",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "K8sVersion", Column: "k8s_version", Kind: "string", Doc: "their Kubernetes version"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Region", Column: "region", Kind: "string", Doc: "their region"},
+			{Method: "Tags", Column: "tags", Kind: "string", Doc: "their tags"},
+		},
+	},
+	{
+		Name:   "NodeBalancer",
+		Plural: "NodeBalancers",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "IPv4", Column: "ipv4", Kind: "string", Doc: "their IPv4 address"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Region", Column: "region", Kind: "string", Doc: "their region"},
+			{Method: "Tags", Column: "tags", Kind: "string", Doc: "their tags"},
+		},
+	},
+	{
+		Name:   "Stackscript",
+		Plural: "StackScripts",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "DeploymentsTotal", Column: "deployments_total", Kind: "int", Doc: "their total number of deployments"},
+			{Method: "Description", Column: "description", Kind: "string", Doc: "their description"},
+			{Method: "IsPublic", Column: "is_public", Kind: "bool", Doc: "whether they are public"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Mine", Column: "mine", Kind: "bool", Doc: "whether they are owned by the current user"},
+			{Method: "Username", Column: "username", Kind: "string", Doc: "the user who created them"},
+		},
+	},
+	{
+		Name:   "Volume",
+		Plural: "volumes",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "LinodeID", Column: "linode_id", Kind: "int", Doc: "the ID of the instance they are attached to"},
+			{Method: "Region", Column: "region", Kind: "string", Doc: "their region"},
+			{Method: "Size", Column: "size", Kind: "int", Doc: "their size in GB"},
+			{Method: "Status", Column: "status", Kind: "string", Type: "VolumeStatus", Doc: "their status"},
+			{Method: "Tags", Column: "tags", Kind: "string", Doc: "their tags"},
+		},
+	},
+	{
+		Name:   "VPC",
+		Plural: "VPCs",
+		Fields: []field{
+			{Method: "ID", Column: "id", Kind: "int", Doc: "their ID"},
+			{Method: "Label", Column: "label", Kind: "string", Doc: "their label"},
+			{Method: "Region", Column: "region", Kind: "string", Doc: "their region"},
+		},
+	},
+}
+
+// FieldType returns the filter field type of the field, e.g. StringFilterField[InstanceStatus].
+func (f field) FieldType() string {
+	switch f.Kind {
+	case "string":
+		return "StringFilterField[" + f.valueType() + "]"
+	case "int", "float64":
+		return "NumberFilterField[" + f.valueType() + "]"
+	case "time":
+		return "TimeFilterField"
+	default:
+		return "FilterField[" + f.valueType() + "]"
+	}
+}
+
+// Value returns the expression of the filter field.
+func (f field) Value() string {
+	switch f.Kind {
+	case "time":
+		return "TimeFilterField{" + `"` + f.Column + `"` + "}"
+	case "bool":
+		return "FilterField[bool]{" + `"` + f.Column + `"` + "}"
+	default:
+		return f.FieldType() + "{FilterField[" + f.valueType() + "]{" + `"` + f.Column + `"` + "}}"
+	}
+}
+
+func (f field) valueType() string {
+	if f.Type != "" {
+		return f.Type
+	}
+
+	return f.Kind
+}
+
+var tmpl = template.Must(template.New("filters").Parse(`// Code generated by internal/filtergen. DO NOT EDIT.
+
+package linodego
+{{ range . }}
+// {{ .Name }}Filter provides the fields {{ .Plural }} can be filtered by.
+var {{ .Name }}Filter {{ .Name }}FilterFields
+
+// {{ .Name }}FilterFields are the fields {{ .Plural }} can be filtered by.
+type {{ .Name }}FilterFields struct{}
+{{ $resource := . }}{{ range .Fields }}
+// {{ .Method }} filters {{ $resource.Plural }} by {{ .Doc }}.
+func ({{ $resource.Name }}FilterFields) {{ .Method }}() {{ .FieldType }} {
+	return {{ .Value }}
+}
+{{ end }}{{ end }}`))
+
+func main() {
+	output := flag.String("output", "filter_resources.go", "the file to write the generated code to")
+	flag.Parse()
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, resources); err != nil {
+		log.Fatalf("failed to generate filters: %s", err)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format generated filters: %s", err)
+	}
+
+	if err := os.WriteFile(*output, source, 0o600); err != nil {
+		log.Fatalf("failed to write generated filters: %s", err)
+	}
+}
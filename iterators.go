@@ -0,0 +1,494 @@
+//go:build go1.23
+
+package linodego
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// iterPaginatedResults returns an iterator over the results of the given paginated
+// endpoint using the provided ListOptions. Unlike getPaginatedResults, each page is
+// only requested once the results of the previous page have been consumed, and no
+// more pages are requested once the consumer stops iterating.
+//
+// The Page, Pages and Results of opts are updated as each page is received.
+// If a request fails, its error is yielded and iteration stops.
+func iterPaginatedResults[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+) iter.Seq2[T, error] {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	if opts.PageOptions == nil {
+		opts.PageOptions = &PageOptions{}
+	}
+
+	// If the user has explicitly specified a page,
+	// we don't need to get any other pages.
+	pageDefined := opts.Page > 0
+	startingPage := max(opts.Page, 1)
+
+	return func(yield func(T, error) bool) {
+		for page := startingPage; ; page++ {
+			var response paginatedResponse[T]
+
+			opts.Page = page
+
+			if err := client.doRequest(
+				ctx,
+				http.MethodGet,
+				endpoint,
+				RequestParams{Response: &response},
+				opts,
+			); err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			opts.Pages = response.Pages
+			opts.Results = response.Results
+
+			for _, result := range response.Data {
+				if !yield(result, nil) {
+					return
+				}
+			}
+
+			if pageDefined || page >= response.Pages {
+				return
+			}
+		}
+	}
+}
+
+// IterAccountAvailabilities iterates over the results of ListAccountAvailabilities, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterAccountAvailabilities(ctx context.Context, opts *ListOptions) iter.Seq2[AccountAvailability, error] {
+	return iterPaginatedResults[AccountAvailability](ctx, c, "account/availability", opts)
+}
+
+// IterAccountBetaPrograms iterates over the results of ListAccountBetaPrograms, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterAccountBetaPrograms(ctx context.Context, opts *ListOptions) iter.Seq2[AccountBetaProgram, error] {
+	return iterPaginatedResults[AccountBetaProgram](ctx, c, "/account/betas", opts)
+}
+
+// IterAllVPCIPAddresses iterates over the results of ListAllVPCIPAddresses, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterAllVPCIPAddresses(ctx context.Context, opts *ListOptions) iter.Seq2[VPCIP, error] {
+	return iterPaginatedResults[VPCIP](ctx, c, "vpcs/ips", opts)
+}
+
+// IterBetaPrograms iterates over the results of ListBetaPrograms, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterBetaPrograms(ctx context.Context, opts *ListOptions) iter.Seq2[BetaProgram, error] {
+	return iterPaginatedResults[BetaProgram](ctx, c, "/betas", opts)
+}
+
+// IterChildAccounts iterates over the results of ListChildAccounts, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterChildAccounts(ctx context.Context, opts *ListOptions) iter.Seq2[ChildAccount, error] {
+	return iterPaginatedResults[ChildAccount](ctx, c, "account/child-accounts", opts)
+}
+
+// IterDatabaseEngines iterates over the results of ListDatabaseEngines, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterDatabaseEngines(ctx context.Context, opts *ListOptions) iter.Seq2[DatabaseEngine, error] {
+	return iterPaginatedResults[DatabaseEngine](ctx, c, "databases/engines", opts)
+}
+
+// IterDatabaseTypes iterates over the results of ListDatabaseTypes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterDatabaseTypes(ctx context.Context, opts *ListOptions) iter.Seq2[DatabaseType, error] {
+	return iterPaginatedResults[DatabaseType](ctx, c, "databases/types", opts)
+}
+
+// IterDatabases iterates over the results of ListDatabases, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterDatabases(ctx context.Context, opts *ListOptions) iter.Seq2[Database, error] {
+	return iterPaginatedResults[Database](ctx, c, "databases/instances", opts)
+}
+
+// IterDomainRecords iterates over the results of ListDomainRecords, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterDomainRecords(ctx context.Context, domainID int, opts *ListOptions) iter.Seq2[DomainRecord, error] {
+	return iterPaginatedResults[DomainRecord](ctx, c, formatAPIPath("domains/%d/records", domainID), opts)
+}
+
+// IterDomains iterates over the results of ListDomains, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterDomains(ctx context.Context, opts *ListOptions) iter.Seq2[Domain, error] {
+	return iterPaginatedResults[Domain](ctx, c, "domains", opts)
+}
+
+// IterEvents iterates over the results of ListEvents, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterEvents(ctx context.Context, opts *ListOptions) iter.Seq2[Event, error] {
+	return iterPaginatedResults[Event](ctx, c, "account/events", opts)
+}
+
+// IterFirewallDevices iterates over the results of ListFirewallDevices, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterFirewallDevices(ctx context.Context, firewallID int, opts *ListOptions) iter.Seq2[FirewallDevice, error] {
+	return iterPaginatedResults[FirewallDevice](ctx, c, formatAPIPath("networking/firewalls/%d/devices", firewallID), opts)
+}
+
+// IterFirewalls iterates over the results of ListFirewalls, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterFirewalls(ctx context.Context, opts *ListOptions) iter.Seq2[Firewall, error] {
+	return iterPaginatedResults[Firewall](ctx, c, "networking/firewalls", opts)
+}
+
+// IterIPAddresses iterates over the results of ListIPAddresses, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterIPAddresses(ctx context.Context, opts *ListOptions) iter.Seq2[InstanceIP, error] {
+	return iterPaginatedResults[InstanceIP](ctx, c, "networking/ips", opts)
+}
+
+// IterIPv6Pools iterates over the results of ListIPv6Pools, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterIPv6Pools(ctx context.Context, opts *ListOptions) iter.Seq2[IPv6Range, error] {
+	return iterPaginatedResults[IPv6Range](ctx, c, "networking/ipv6/pools", opts)
+}
+
+// IterIPv6Ranges iterates over the results of ListIPv6Ranges, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterIPv6Ranges(ctx context.Context, opts *ListOptions) iter.Seq2[IPv6Range, error] {
+	return iterPaginatedResults[IPv6Range](ctx, c, "networking/ipv6/ranges", opts)
+}
+
+// IterImages iterates over the results of ListImages, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterImages(ctx context.Context, opts *ListOptions) iter.Seq2[Image, error] {
+	return iterPaginatedResults[Image](ctx, c, "images", opts)
+}
+
+// IterInstanceConfigs iterates over the results of ListInstanceConfigs, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterInstanceConfigs(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[InstanceConfig, error] {
+	return iterPaginatedResults[InstanceConfig](ctx, c, formatAPIPath("linode/instances/%d/configs", linodeID), opts)
+}
+
+// IterInstanceDisks iterates over the results of ListInstanceDisks, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterInstanceDisks(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[InstanceDisk, error] {
+	return iterPaginatedResults[InstanceDisk](ctx, c, formatAPIPath("linode/instances/%d/disks", linodeID), opts)
+}
+
+// IterInstanceFirewalls iterates over the results of ListInstanceFirewalls, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterInstanceFirewalls(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[Firewall, error] {
+	return iterPaginatedResults[Firewall](ctx, c, formatAPIPath("linode/instances/%d/firewalls", linodeID), opts)
+}
+
+// IterInstanceVolumes iterates over the results of ListInstanceVolumes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterInstanceVolumes(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[Volume, error] {
+	return iterPaginatedResults[Volume](ctx, c, formatAPIPath("linode/instances/%d/volumes", linodeID), opts)
+}
+
+// IterInstances iterates over the results of ListInstances, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterInstances(ctx context.Context, opts *ListOptions) iter.Seq2[Instance, error] {
+	return iterPaginatedResults[Instance](ctx, c, "linode/instances", opts)
+}
+
+// IterInvoiceItems iterates over the results of ListInvoiceItems, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterInvoiceItems(ctx context.Context, invoiceID int, opts *ListOptions) iter.Seq2[InvoiceItem, error] {
+	return iterPaginatedResults[InvoiceItem](ctx, c, formatAPIPath("account/invoices/%d/items", invoiceID), opts)
+}
+
+// IterInvoices iterates over the results of ListInvoices, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterInvoices(ctx context.Context, opts *ListOptions) iter.Seq2[Invoice, error] {
+	return iterPaginatedResults[Invoice](ctx, c, "account/invoices", opts)
+}
+
+// IterKernels iterates over the results of ListKernels, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterKernels(ctx context.Context, opts *ListOptions) iter.Seq2[LinodeKernel, error] {
+	return iterPaginatedResults[LinodeKernel](ctx, c, "linode/kernels", opts)
+}
+
+// IterLKEClusterAPIEndpoints iterates over the results of ListLKEClusterAPIEndpoints, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLKEClusterAPIEndpoints(ctx context.Context, clusterID int, opts *ListOptions) iter.Seq2[LKEClusterAPIEndpoint, error] {
+	return iterPaginatedResults[LKEClusterAPIEndpoint](ctx, c, formatAPIPath("lke/clusters/%d/api-endpoints", clusterID), opts)
+}
+
+// IterLKEClusters iterates over the results of ListLKEClusters, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLKEClusters(ctx context.Context, opts *ListOptions) iter.Seq2[LKECluster, error] {
+	return iterPaginatedResults[LKECluster](ctx, c, "lke/clusters", opts)
+}
+
+// IterLKENodePools iterates over the results of ListLKENodePools, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLKENodePools(ctx context.Context, clusterID int, opts *ListOptions) iter.Seq2[LKENodePool, error] {
+	return iterPaginatedResults[LKENodePool](ctx, c, formatAPIPath("lke/clusters/%d/pools", clusterID), opts)
+}
+
+// IterLKETypes iterates over the results of ListLKETypes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLKETypes(ctx context.Context, opts *ListOptions) iter.Seq2[LKEType, error] {
+	return iterPaginatedResults[LKEType](ctx, c, "lke/types", opts)
+}
+
+// IterLKEVersions iterates over the results of ListLKEVersions, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLKEVersions(ctx context.Context, opts *ListOptions) iter.Seq2[LKEVersion, error] {
+	return iterPaginatedResults[LKEVersion](ctx, c, "lke/versions", opts)
+}
+
+// IterLogins iterates over the results of ListLogins, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLogins(ctx context.Context, opts *ListOptions) iter.Seq2[Login, error] {
+	return iterPaginatedResults[Login](ctx, c, "account/logins", opts)
+}
+
+// IterLongviewClients iterates over the results of ListLongviewClients, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLongviewClients(ctx context.Context, opts *ListOptions) iter.Seq2[LongviewClient, error] {
+	return iterPaginatedResults[LongviewClient](ctx, c, "longview/clients", opts)
+}
+
+// IterLongviewSubscriptions iterates over the results of ListLongviewSubscriptions, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterLongviewSubscriptions(ctx context.Context, opts *ListOptions) iter.Seq2[LongviewSubscription, error] {
+	return iterPaginatedResults[LongviewSubscription](ctx, c, "longview/subscriptions", opts)
+}
+
+// IterMySQLDatabaseBackups iterates over the results of ListMySQLDatabaseBackups, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterMySQLDatabaseBackups(ctx context.Context, databaseID int, opts *ListOptions) iter.Seq2[MySQLDatabaseBackup, error] {
+	return iterPaginatedResults[MySQLDatabaseBackup](ctx, c, formatAPIPath("databases/mysql/instances/%d/backups", databaseID), opts)
+}
+
+// IterMySQLDatabases iterates over the results of ListMySQLDatabases, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterMySQLDatabases(ctx context.Context, opts *ListOptions) iter.Seq2[MySQLDatabase, error] {
+	return iterPaginatedResults[MySQLDatabase](ctx, c, "databases/mysql/instances", opts)
+}
+
+// IterNetworkTransferPrices iterates over the results of ListNetworkTransferPrices, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterNetworkTransferPrices(ctx context.Context, opts *ListOptions) iter.Seq2[NetworkTransferPrice, error] {
+	return iterPaginatedResults[NetworkTransferPrice](ctx, c, "network-transfer/prices", opts)
+}
+
+// IterNodeBalancerConfigs iterates over the results of ListNodeBalancerConfigs, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterNodeBalancerConfigs(ctx context.Context, nodebalancerID int, opts *ListOptions) iter.Seq2[NodeBalancerConfig, error] {
+	return iterPaginatedResults[NodeBalancerConfig](ctx, c, formatAPIPath("nodebalancers/%d/configs", nodebalancerID), opts)
+}
+
+// IterNodeBalancerFirewalls iterates over the results of ListNodeBalancerFirewalls, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *ListOptions) iter.Seq2[Firewall, error] {
+	return iterPaginatedResults[Firewall](ctx, c, formatAPIPath("nodebalancers/%d/firewalls", nodebalancerID), opts)
+}
+
+// IterNodeBalancerNodes iterates over the results of ListNodeBalancerNodes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterNodeBalancerNodes(ctx context.Context, nodebalancerID int, configID int, opts *ListOptions) iter.Seq2[NodeBalancerNode, error] {
+	return iterPaginatedResults[NodeBalancerNode](ctx, c, formatAPIPath("nodebalancers/%d/configs/%d/nodes", nodebalancerID, configID), opts)
+}
+
+// IterNodeBalancerTypes iterates over the results of ListNodeBalancerTypes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterNodeBalancerTypes(ctx context.Context, opts *ListOptions) iter.Seq2[NodeBalancerType, error] {
+	return iterPaginatedResults[NodeBalancerType](ctx, c, "nodebalancers/types", opts)
+}
+
+// IterNodeBalancers iterates over the results of ListNodeBalancers, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterNodeBalancers(ctx context.Context, opts *ListOptions) iter.Seq2[NodeBalancer, error] {
+	return iterPaginatedResults[NodeBalancer](ctx, c, "nodebalancers", opts)
+}
+
+// IterNotifications iterates over the results of ListNotifications, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterNotifications(ctx context.Context, opts *ListOptions) iter.Seq2[Notification, error] {
+	return iterPaginatedResults[Notification](ctx, c, "account/notifications", opts)
+}
+
+// IterOAuthClients iterates over the results of ListOAuthClients, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterOAuthClients(ctx context.Context, opts *ListOptions) iter.Seq2[OAuthClient, error] {
+	return iterPaginatedResults[OAuthClient](ctx, c, "account/oauth-clients", opts)
+}
+
+// IterObjectStorageBuckets iterates over the results of ListObjectStorageBuckets, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterObjectStorageBuckets(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageBucket, error] {
+	return iterPaginatedResults[ObjectStorageBucket](ctx, c, "object-storage/buckets", opts)
+}
+
+// IterObjectStorageBucketsInCluster iterates over the results of ListObjectStorageBucketsInCluster, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterObjectStorageBucketsInCluster(ctx context.Context, opts *ListOptions, clusterOrRegionID string) iter.Seq2[ObjectStorageBucket, error] {
+	return iterPaginatedResults[ObjectStorageBucket](ctx, c, formatAPIPath("object-storage/buckets/%s", clusterOrRegionID), opts)
+}
+
+// IterObjectStorageClusters iterates over the results of ListObjectStorageClusters, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterObjectStorageClusters(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageCluster, error] {
+	return iterPaginatedResults[ObjectStorageCluster](ctx, c, "object-storage/clusters", opts)
+}
+
+// IterObjectStorageKeys iterates over the results of ListObjectStorageKeys, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterObjectStorageKeys(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageKey, error] {
+	return iterPaginatedResults[ObjectStorageKey](ctx, c, "object-storage/keys", opts)
+}
+
+// IterPayments iterates over the results of ListPayments, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterPayments(ctx context.Context, opts *ListOptions) iter.Seq2[Payment, error] {
+	return iterPaginatedResults[Payment](ctx, c, "account/payments", opts)
+}
+
+// IterPlacementGroups iterates over the results of ListPlacementGroups, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterPlacementGroups(ctx context.Context, opts *ListOptions) iter.Seq2[PlacementGroup, error] {
+	return iterPaginatedResults[PlacementGroup](ctx, c, "placement/groups", opts)
+}
+
+// IterPostgresDatabaseBackups iterates over the results of ListPostgresDatabaseBackups, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterPostgresDatabaseBackups(ctx context.Context, databaseID int, opts *ListOptions) iter.Seq2[PostgresDatabaseBackup, error] {
+	return iterPaginatedResults[PostgresDatabaseBackup](ctx, c, formatAPIPath("databases/postgresql/instances/%d/backups", databaseID), opts)
+}
+
+// IterPostgresDatabases iterates over the results of ListPostgresDatabases, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterPostgresDatabases(ctx context.Context, opts *ListOptions) iter.Seq2[PostgresDatabase, error] {
+	return iterPaginatedResults[PostgresDatabase](ctx, c, "databases/postgresql/instances", opts)
+}
+
+// IterProfileLogins iterates over the results of ListProfileLogins, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterProfileLogins(ctx context.Context, opts *ListOptions) iter.Seq2[ProfileLogin, error] {
+	return iterPaginatedResults[ProfileLogin](ctx, c, "profile/logins", opts)
+}
+
+// IterRegions iterates over the results of ListRegions, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterRegions(ctx context.Context, opts *ListOptions) iter.Seq2[Region, error] {
+	return iterPaginatedResults[Region](ctx, c, "regions", opts)
+}
+
+// IterRegionsAvailability iterates over the results of ListRegionsAvailability, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterRegionsAvailability(ctx context.Context, opts *ListOptions) iter.Seq2[RegionAvailability, error] {
+	return iterPaginatedResults[RegionAvailability](ctx, c, "regions/availability", opts)
+}
+
+// IterReservedIPAddresses iterates over the results of ListReservedIPAddresses, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterReservedIPAddresses(ctx context.Context, opts *ListOptions) iter.Seq2[InstanceIP, error] {
+	return iterPaginatedResults[InstanceIP](ctx, c, formatAPIPath("networking/reserved/ips"), opts)
+}
+
+// IterSSHKeys iterates over the results of ListSSHKeys, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterSSHKeys(ctx context.Context, opts *ListOptions) iter.Seq2[SSHKey, error] {
+	return iterPaginatedResults[SSHKey](ctx, c, "profile/sshkeys", opts)
+}
+
+// IterStackscripts iterates over the results of ListStackscripts, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterStackscripts(ctx context.Context, opts *ListOptions) iter.Seq2[Stackscript, error] {
+	return iterPaginatedResults[Stackscript](ctx, c, "linode/stackscripts", opts)
+}
+
+// IterTaggedObjects iterates over the results of ListTaggedObjects, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterTaggedObjects(ctx context.Context, label string, opts *ListOptions) iter.Seq2[TaggedObject, error] {
+	return func(yield func(TaggedObject, error) bool) {
+		for object, err := range iterPaginatedResults[TaggedObject](ctx, c, formatAPIPath("tags/%s", label), opts) {
+			if err == nil {
+				_, err = object.fixData()
+			}
+
+			if !yield(object, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// IterTags iterates over the results of ListTags, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterTags(ctx context.Context, opts *ListOptions) iter.Seq2[Tag, error] {
+	return iterPaginatedResults[Tag](ctx, c, "tags", opts)
+}
+
+// IterTickets iterates over the results of ListTickets, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterTickets(ctx context.Context, opts *ListOptions) iter.Seq2[Ticket, error] {
+	return iterPaginatedResults[Ticket](ctx, c, "support/tickets", opts)
+}
+
+// IterTokens iterates over the results of ListTokens, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterTokens(ctx context.Context, opts *ListOptions) iter.Seq2[Token, error] {
+	return iterPaginatedResults[Token](ctx, c, "profile/tokens", opts)
+}
+
+// IterTypes iterates over the results of ListTypes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterTypes(ctx context.Context, opts *ListOptions) iter.Seq2[LinodeType, error] {
+	return iterPaginatedResults[LinodeType](ctx, c, "linode/types", opts)
+}
+
+// IterUsers iterates over the results of ListUsers, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterUsers(ctx context.Context, opts *ListOptions) iter.Seq2[User, error] {
+	return iterPaginatedResults[User](ctx, c, "account/users", opts)
+}
+
+// IterVLANs iterates over the results of ListVLANs, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterVLANs(ctx context.Context, opts *ListOptions) iter.Seq2[VLAN, error] {
+	return iterPaginatedResults[VLAN](ctx, c, "networking/vlans", opts)
+}
+
+// IterVPCIPAddresses iterates over the results of ListVPCIPAddresses, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterVPCIPAddresses(ctx context.Context, vpcID int, opts *ListOptions) iter.Seq2[VPCIP, error] {
+	return iterPaginatedResults[VPCIP](ctx, c, formatAPIPath("vpcs/%d/ips", vpcID), opts)
+}
+
+// IterVPCSubnets iterates over the results of ListVPCSubnets, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterVPCSubnets(ctx context.Context, vpcID int, opts *ListOptions) iter.Seq2[VPCSubnet, error] {
+	return iterPaginatedResults[VPCSubnet](ctx, c, formatAPIPath("vpcs/%d/subnets", vpcID), opts)
+}
+
+// IterVPCs iterates over the results of ListVPCs, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterVPCs(ctx context.Context, opts *ListOptions) iter.Seq2[VPC, error] {
+	return iterPaginatedResults[VPC](ctx, c, "vpcs", opts)
+}
+
+// IterVolumeTypes iterates over the results of ListVolumeTypes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterVolumeTypes(ctx context.Context, opts *ListOptions) iter.Seq2[VolumeType, error] {
+	return iterPaginatedResults[VolumeType](ctx, c, "volumes/types", opts)
+}
+
+// IterVolumes iterates over the results of ListVolumes, requesting each page
+// once the results of the previous page have been consumed.
+func (c *Client) IterVolumes(ctx context.Context, opts *ListOptions) iter.Seq2[Volume, error] {
+	return iterPaginatedResults[Volume](ctx, c, "volumes", opts)
+}
@@ -0,0 +1,149 @@
+//go:build go1.23
+
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterators_paginateAll(t *testing.T) {
+	const totalResults = 4123
+
+	client := testutil.CreateMockClient(t, NewClient)
+
+	numRequests := 0
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		mockPaginatedResponse(
+			buildPaginatedEntries(totalResults),
+			&numRequests,
+		),
+	)
+
+	opts := &ListOptions{PageSize: 500}
+	count := 0
+
+	for entry, err := range iterPaginatedResults[testResultType](context.Background(), client, "/foo/bar", opts) {
+		require.NoError(t, err)
+		require.Equal(t, count, entry.ID)
+		require.Equal(t, fmt.Sprintf("test-%d", count), *entry.Bar)
+
+		// Pages are only requested once the previous page has been consumed
+		require.Equal(t, count/500+1, numRequests)
+		require.Equal(t, count/500+1, opts.Page)
+
+		count++
+	}
+
+	require.Equal(t, totalResults, count)
+	require.Equal(t, 9, numRequests)
+	require.Equal(t, 9, opts.Pages)
+}
+
+func TestIterators_break(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	numRequests := 0
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		mockPaginatedResponse(buildPaginatedEntries(12), &numRequests),
+	)
+
+	results := iterPaginatedResults[testResultType](context.Background(), client, "/foo/bar", nil)
+
+	for entry, err := range results {
+		require.NoError(t, err)
+
+		if entry.ID == 4 {
+			break
+		}
+	}
+
+	require.Equal(t, 2, numRequests)
+
+	// Iterators can be reused, starting from the first page
+	for entry, err := range results {
+		require.NoError(t, err)
+		require.Equal(t, 0, entry.ID)
+
+		break
+	}
+
+	require.Equal(t, 3, numRequests)
+}
+
+func TestIterators_paginateSingle(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	numRequests := 0
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		mockPaginatedResponse(buildPaginatedEntries(12), &numRequests),
+	)
+
+	var ids []int
+
+	opts := &ListOptions{PageOptions: &PageOptions{Page: 3}}
+
+	for entry, err := range iterPaginatedResults[testResultType](context.Background(), client, "/foo/bar", opts) {
+		require.NoError(t, err)
+
+		ids = append(ids, entry.ID)
+	}
+
+	require.Equal(t, 1, numRequests)
+	require.Equal(t, []int{6, 7, 8}, ids)
+}
+
+func TestIterators_error(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/linode/instances"),
+		httpmock.NewJsonResponderOrPanic(http.StatusBadRequest, APIError{
+			Errors: []APIErrorReason{{Reason: "Invalid filter"}},
+		}),
+	)
+
+	count := 0
+
+	for _, err := range client.IterInstances(context.Background(), nil) {
+		require.ErrorContains(t, err, "Invalid filter")
+
+		count++
+	}
+
+	require.Equal(t, 1, count)
+}
+
+func TestIterators_taggedObjects(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/tags/prod"),
+		httpmock.NewStringResponder(
+			http.StatusOK,
+			`{"data": [{"type": "linode", "data": {"id": 123}}], "page": 1, "pages": 1, "results": 1}`,
+		),
+	)
+
+	for object, err := range client.IterTaggedObjects(context.Background(), "prod", nil) {
+		require.NoError(t, err)
+		require.Equal(t, 123, object.Data.(Instance).ID)
+	}
+}
@@ -0,0 +1,127 @@
+package linodegotest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// cassetteVersion is the version of the fixture format, which is compatible
+// with version 1 of the go-vcr cassette format.
+const cassetteVersion = 1
+
+// Cassette is a fixture file containing recorded interactions with the Linode API.
+type Cassette struct {
+	Version      int            `yaml:"version"`
+	Interactions []*Interaction `yaml:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+
+	replayed bool
+}
+
+// Request is a recorded request.
+type Request struct {
+	Body    string      `yaml:"body"`
+	Form    url.Values  `yaml:"form"`
+	Headers http.Header `yaml:"headers"`
+	URL     string      `yaml:"url"`
+	Method  string      `yaml:"method"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	Body     string      `yaml:"body"`
+	Headers  http.Header `yaml:"headers"`
+	Status   string      `yaml:"status"`
+	Code     int         `yaml:"code"`
+	Duration string      `yaml:"duration"`
+}
+
+// LoadCassette reads the cassette at the given path.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := yaml.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+
+	if cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("unsupported cassette version %d in %s", cassette.Version, path)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette to the given path, creating its directory if necessary.
+func (c *Cassette) Save(path string) error {
+	if c.Version == 0 {
+		c.Version = cassetteVersion
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("---\n")
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(c); err != nil {
+		return err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644) //nolint:gosec
+}
+
+// ErrInteractionNotFound is returned when replaying a request
+// for which no matching interaction was recorded.
+var ErrInteractionNotFound = errors.New("no matching interaction found")
+
+// find returns the first interaction matching the given request that has not been replayed,
+// or the first matching interaction if allowRepeat is true and all have been replayed.
+func (c *Cassette) find(actual Request, matcher Matcher, allowRepeat bool) (*Interaction, error) {
+	var repeated *Interaction
+
+	for _, i := range c.Interactions {
+		if !matcher(actual, i.Request) {
+			continue
+		}
+
+		if !i.replayed {
+			i.replayed = true
+			return i, nil
+		}
+
+		if repeated == nil {
+			repeated = i
+		}
+	}
+
+	if allowRepeat && repeated != nil {
+		return repeated, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, actual.Method, actual.URL)
+}
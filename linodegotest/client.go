@@ -0,0 +1,56 @@
+package linodegotest
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+// replayToken is the token used when replaying interactions.
+const replayToken = "NOTANAPIKEY"
+
+// NewClient creates a linodego.Client that records or replays interactions using the
+// fixture file at the given path. If opts is nil, the mode is read using ModeFromEnv.
+// The recorder is stopped, saving recorded interactions, once the test completes.
+//
+// When recording, the token is read from the LINODE_TOKEN environment variable.
+// When replaying, the client does not wait between polls or retries.
+func NewClient(t testing.TB, path string, opts *Options) *linodego.Client {
+	t.Helper()
+
+	if opts == nil {
+		opts = &Options{Mode: ModeFromEnv()}
+	}
+
+	recorder, err := NewRecorder(path, opts)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := recorder.Stop(); err != nil {
+			t.Errorf("failed to save fixture %s: %s", path, err)
+		}
+	})
+
+	token := replayToken
+
+	if opts.Mode != ModeReplay {
+		var ok bool
+		if token, ok = os.LookupEnv(linodego.APIEnvVar); !ok {
+			t.Fatalf("%s must be set to record fixtures", linodego.APIEnvVar)
+		}
+	}
+
+	client := linodego.NewClient(&http.Client{Transport: recorder})
+	client.SetToken(token)
+
+	if opts.Mode == ModeReplay {
+		client.SetPollDelay(time.Millisecond).SetRetryWaitTime(0)
+	}
+
+	return &client
+}
@@ -0,0 +1,376 @@
+package linodegotest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/linode/linodego"
+	"golang.org/x/net/http2"
+)
+
+// Fault fails a request instead of, or in addition to, sending it using next.
+type Fault func(req *http.Request, next http.RoundTripper) (*http.Response, error)
+
+// FaultRule selects the requests a fault is injected into.
+type FaultRule struct {
+	// Fault is the fault injected into selected requests.
+	Fault Fault
+
+	// Script, if set, lists the faults injected into successive requests selected by
+	// the rule instead of Fault, e.g. to script a request failing twice before succeeding.
+	// Requests selected for nil faults are sent without faults. Once the script has
+	// completed, the rule no longer selects requests.
+	Script []Fault
+
+	// Method restricts the rule to requests with the given method, if set.
+	Method string
+
+	// Path restricts the rule to requests with URL paths matching the given
+	// expression, if set, e.g. regexp.MustCompile(`/linode/instances/\d+$`).
+	Path *regexp.Regexp
+
+	// Probability is the probability of injecting the fault into a request matching
+	// the rule, between 0 and 1. Defaults to 1, injecting the fault into all requests.
+	Probability float64
+
+	// Times limits how often the fault is injected, if set.
+	Times int
+}
+
+// FaultOptions configure a FaultTransport.
+type FaultOptions struct {
+	// Transport is used to send requests without faults, and by faults
+	// modifying responses. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Rules are evaluated in order for each request. The fault of the first rule
+	// selecting the request is injected; other requests are sent without faults.
+	Rules []FaultRule
+
+	// Seed seeds the random numbers used to inject faults by probability,
+	// making the requests faults are injected into reproducible.
+	Seed uint64
+}
+
+// FaultTransport is an http.RoundTripper injecting faults into requests to test the
+// behavior of clients when the Linode API is unavailable, rate limits requests or
+// returns unexpected responses.
+type FaultTransport struct {
+	mu sync.Mutex
+
+	opts     FaultOptions
+	rand     *rand.Rand
+	scripted []int
+	injected []int
+	requests int
+}
+
+// NewFaultTransport creates a FaultTransport.
+func NewFaultTransport(opts *FaultOptions) *FaultTransport {
+	t := &FaultTransport{}
+
+	if opts != nil {
+		t.opts = *opts
+	}
+
+	if t.opts.Transport == nil {
+		t.opts.Transport = http.DefaultTransport
+	}
+
+	t.rand = rand.New(rand.NewPCG(t.opts.Seed, t.opts.Seed)) //nolint:gosec
+	t.scripted = make([]int, len(t.opts.Rules))
+	t.injected = make([]int, len(t.opts.Rules))
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fault := t.selectFault(req)
+	if fault == nil {
+		return t.opts.Transport.RoundTrip(req)
+	}
+
+	return fault(req, t.opts.Transport)
+}
+
+// Requests returns the number of requests received by the transport.
+func (t *FaultTransport) Requests() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.requests
+}
+
+// Injected returns the number of faults injected by the transport.
+func (t *FaultTransport) Injected() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	total := 0
+	for _, injected := range t.injected {
+		total += injected
+	}
+
+	return total
+}
+
+// selectFault returns the fault to inject into the given request, if any.
+func (t *FaultTransport) selectFault(req *http.Request) Fault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.requests++
+
+	for i, rule := range t.opts.Rules {
+		if rule.Method != "" && rule.Method != req.Method {
+			continue
+		}
+
+		if rule.Path != nil && !rule.Path.MatchString(req.URL.Path) {
+			continue
+		}
+
+		if rule.Times > 0 && t.injected[i] >= rule.Times {
+			continue
+		}
+
+		if len(rule.Script) > 0 && t.scripted[i] >= len(rule.Script) {
+			continue
+		}
+
+		if rule.Probability > 0 && t.rand.Float64() >= rule.Probability {
+			continue
+		}
+
+		fault := rule.Fault
+
+		if len(rule.Script) > 0 {
+			fault = rule.Script[t.scripted[i]]
+			t.scripted[i]++
+
+			if fault == nil {
+				return nil
+			}
+		}
+
+		t.injected[i]++
+
+		return fault
+	}
+
+	return nil
+}
+
+// Respond returns a Fault responding with the given status, headers and body
+// without sending requests.
+func Respond(status int, header http.Header, body string) Fault {
+	return func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+
+		response := Response{Body: body, Headers: header, Code: status}
+
+		return response.toHTTP(req), nil
+	}
+}
+
+// respondWithAPIError returns a Fault responding with an API error with the given reason.
+func respondWithAPIError(status int, header http.Header, reason string) Fault {
+	body, _ := json.Marshal(linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: reason}}})
+
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	header.Set("Content-Type", "application/json")
+
+	return Respond(status, header, string(body))
+}
+
+// retryAfterHeader returns the Retry-After header for the given delay, rounded up to whole seconds.
+func retryAfterHeader(retryAfter time.Duration) http.Header {
+	header := make(http.Header)
+	header.Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+
+	return header
+}
+
+// TooManyRequests returns a Fault responding with 429 Too Many Requests,
+// asking clients to retry after the given delay.
+func TooManyRequests(retryAfter time.Duration) Fault {
+	return respondWithAPIError(http.StatusTooManyRequests, retryAfterHeader(retryAfter), "Too many requests")
+}
+
+// ServiceUnavailable returns a Fault responding with 503 Service Unavailable.
+func ServiceUnavailable() Fault {
+	return respondWithAPIError(http.StatusServiceUnavailable, nil, "Service unavailable")
+}
+
+// Maintenance returns a Fault responding with 503 Service Unavailable with the given
+// X-Maintenance-Mode header, as the API does while it is under maintenance, asking
+// clients to retry after the given delay.
+func Maintenance(mode string, retryAfter time.Duration) Fault {
+	header := retryAfterHeader(retryAfter)
+	header.Set("X-Maintenance-Mode", mode)
+
+	return respondWithAPIError(http.StatusServiceUnavailable, header, "Currently in maintenance mode.")
+}
+
+// LinodeBusy returns a Fault responding with the 400 Bad Request returned
+// by the API when an instance is busy with another operation.
+func LinodeBusy() Fault {
+	return respondWithAPIError(http.StatusBadRequest, nil, "Linode busy.")
+}
+
+// RequestTimeout returns a Fault responding with 408 Request Timeout.
+func RequestTimeout() Fault {
+	return respondWithAPIError(http.StatusRequestTimeout, nil, "Request timeout")
+}
+
+// NGINXBadRequest returns a Fault responding with the HTML 400 Bad Request page of nginx,
+// which is returned by the load balancers of the API for some transient failures.
+func NGINXBadRequest() Fault {
+	header := make(http.Header)
+	header.Set("Content-Type", "text/html")
+	header.Set("Server", "nginx")
+
+	return Respond(http.StatusBadRequest, header, nginxPage("400 Bad Request"))
+}
+
+// BadGateway returns a Fault responding with the HTML 502 Bad Gateway page of nginx,
+// which is returned when the API fails to respond to the load balancers.
+func BadGateway() Fault {
+	header := make(http.Header)
+	header.Set("Content-Type", "text/html")
+	header.Set("Server", "nginx")
+
+	return Respond(http.StatusBadGateway, header, nginxPage("502 Bad Gateway"))
+}
+
+func nginxPage(title string) string {
+	return "<html>\r\n<head><title>" + title + "</title></head>\r\n<body>\r\n<center><h1>" + title +
+		"</h1></center>\r\n<hr><center>nginx</center>\r\n</body>\r\n</html>\r\n"
+}
+
+// GoAway returns a Fault failing requests with the error returned when an HTTP/2
+// server closes the connection using a GOAWAY frame before responding.
+func GoAway() Fault {
+	return Fail(http2.GoAwayError{LastStreamID: 1, ErrCode: http2.ErrCodeNo, DebugData: "server shutting down"})
+}
+
+// Fail returns a Fault failing requests with the given error without sending them,
+// e.g. syscall.ECONNRESET.
+func Fail(err error) Fault {
+	return func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+
+		return nil, err
+	}
+}
+
+// Delay returns a Fault sending requests after the given delay, or failing
+// them if their context is cancelled first.
+func Delay(delay time.Duration) Fault {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			return next.RoundTrip(req)
+		case <-req.Context().Done():
+			if req.Body != nil {
+				req.Body.Close()
+			}
+
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// SlowBody returns a Fault sending requests and delaying the body of their responses
+// by the given delay, or failing to read it if the request context is cancelled first.
+func SlowBody(delay time.Duration) Fault {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = &slowReader{ReadCloser: resp.Body, ctx: req.Context(), delay: delay}
+
+		return resp, nil
+	}
+}
+
+type slowReader struct {
+	io.ReadCloser
+
+	ctx     context.Context
+	delay   time.Duration
+	delayed bool
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if !r.delayed {
+		r.delayed = true
+
+		timer := time.NewTimer(r.delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-r.ctx.Done():
+			return 0, r.ctx.Err()
+		}
+	}
+
+	return r.ReadCloser.Read(p)
+}
+
+// TruncatedBody returns a Fault sending requests and truncating the body of their
+// responses after the given number of bytes, failing to read the rest of the body
+// with io.ErrUnexpectedEOF as when the connection is closed early.
+func TruncatedBody(size int) Fault {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = io.NopCloser(io.MultiReader(
+			bytes.NewReader(body[:min(size, len(body))]),
+			&errorReader{err: io.ErrUnexpectedEOF},
+		))
+
+		return resp, nil
+	}
+}
+
+type errorReader struct {
+	err error
+}
+
+func (r *errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,141 @@
+package linodegotest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func newFaultClient(t *testing.T, rules ...FaultRule) (*linodego.Client, *FaultTransport) {
+	t.Helper()
+
+	server := NewServer(nil)
+	t.Cleanup(server.Close)
+
+	transport := NewFaultTransport(&FaultOptions{Rules: rules})
+
+	client := linodego.NewClient(&http.Client{Transport: transport})
+	client.SetBaseURL(server.URL).
+		SetToken(replayToken).
+		SetRetryWaitTime(time.Millisecond).
+		SetRetryMaxWaitTime(time.Millisecond)
+
+	return &client, transport
+}
+
+func TestFaultTransport_Retried(t *testing.T) {
+	for name, fault := range map[string]Fault{
+		"TooManyRequests":    TooManyRequests(0),
+		"ServiceUnavailable": ServiceUnavailable(),
+		"LinodeBusy":         LinodeBusy(),
+		"RequestTimeout":     RequestTimeout(),
+		"NGINXBadRequest":    NGINXBadRequest(),
+		"GoAway":             GoAway(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, transport := newFaultClient(t, FaultRule{Script: []Fault{fault, nil, fault}})
+
+			_, err := client.ListInstances(context.Background(), nil)
+			require.NoError(t, err)
+			require.Equal(t, 1, transport.Injected())
+			require.Equal(t, 2, transport.Requests())
+
+			_, err = client.ListInstances(context.Background(), nil)
+			require.NoError(t, err)
+			require.Equal(t, 2, transport.Injected())
+			require.Equal(t, 4, transport.Requests())
+		})
+	}
+}
+
+func TestFaultTransport_NotRetried(t *testing.T) {
+	for name, test := range map[string]struct {
+		fault Fault
+		err   string
+	}{
+		"BadGateway":    {BadGateway(), "[502] Bad Gateway"},
+		"Maintenance":   {Maintenance("api", 0), "Linode API is under maintenance (api)"},
+		"TruncatedBody": {TruncatedBody(10), io.ErrUnexpectedEOF.Error()},
+		"Fail":          {Fail(syscall.ECONNRESET), syscall.ECONNRESET.Error()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, transport := newFaultClient(t, FaultRule{Fault: test.fault})
+
+			_, err := client.ListInstances(context.Background(), nil)
+			require.ErrorContains(t, err, test.err)
+			require.Equal(t, 1, transport.Requests())
+		})
+	}
+}
+
+func TestFaultTransport_Rules(t *testing.T) {
+	client, transport := newFaultClient(t,
+		FaultRule{Fault: LinodeBusy(), Method: http.MethodPost, Path: regexp.MustCompile(`/domains$`), Times: 1},
+		FaultRule{Fault: Fail(syscall.ECONNREFUSED), Path: regexp.MustCompile(`/volumes$`)},
+	)
+
+	_, err := client.CreateDomain(context.Background(), linodego.DomainCreateOptions{
+		Domain:   "example.com",
+		Type:     linodego.DomainTypeMaster,
+		SOAEmail: "admin@example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, transport.Requests())
+
+	_, err = client.ListDomains(context.Background(), nil)
+	require.NoError(t, err)
+
+	_, err = client.ListVolumes(context.Background(), nil)
+	require.ErrorContains(t, err, syscall.ECONNREFUSED.Error())
+	require.Equal(t, 2, transport.Injected())
+}
+
+func TestFaultTransport_Probability(t *testing.T) {
+	injected := func(seed uint64) int {
+		transport := NewFaultTransport(&FaultOptions{
+			Rules: []FaultRule{{Fault: ServiceUnavailable(), Probability: 0.25}},
+			Seed:  seed,
+		})
+
+		for range 200 {
+			req, err := http.NewRequest(http.MethodGet, "http://localhost/v4/regions", nil)
+			require.NoError(t, err)
+
+			// Requests without faults fail, as there is no server
+			if resp, err := transport.RoundTrip(req); err == nil {
+				resp.Body.Close()
+			}
+		}
+
+		return transport.Injected()
+	}
+
+	count := injected(1)
+	require.InDelta(t, 50, count, 20)
+	require.Equal(t, count, injected(1))
+}
+
+func TestFaultTransport_Delays(t *testing.T) {
+	client, _ := newFaultClient(t, FaultRule{Fault: SlowBody(time.Second)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.ListInstances(ctx, nil)
+	require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+
+	client, _ = newFaultClient(t, FaultRule{Fault: Delay(10 * time.Millisecond)})
+
+	start := time.Now()
+
+	_, err = client.ListInstances(context.Background(), nil)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
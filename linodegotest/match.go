@@ -0,0 +1,74 @@
+package linodegotest
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+)
+
+// Matcher returns whether an actual request matches a recorded request.
+// Actual requests are sanitized before they are matched.
+type Matcher func(actual, recorded Request) bool
+
+// MatchMethodAndURL matches requests with the same method and URL.
+// It is the default Matcher.
+func MatchMethodAndURL(actual, recorded Request) bool {
+	return actual.Method == recorded.Method && actual.URL == recorded.URL
+}
+
+// MatchMethodAndPath matches requests with the same method and URL path, ignoring
+// the host and query (e.g. when replaying fixtures against a different API URL).
+func MatchMethodAndPath(actual, recorded Request) bool {
+	if actual.Method != recorded.Method {
+		return false
+	}
+
+	actualURL, err := url.Parse(actual.URL)
+	if err != nil {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return actualURL.Path == recordedURL.Path
+}
+
+// MatchBody matches requests with equivalent bodies. JSON bodies are
+// compared semantically, meaning the order of fields is ignored.
+func MatchBody(actual, recorded Request) bool {
+	if actual.Body == recorded.Body {
+		return true
+	}
+
+	var actualBody, recordedBody any
+
+	if json.Unmarshal([]byte(actual.Body), &actualBody) != nil ||
+		json.Unmarshal([]byte(recorded.Body), &recordedBody) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(actualBody, recordedBody)
+}
+
+// MatchHeader returns a Matcher matching requests with the same values of the given header.
+func MatchHeader(name string) Matcher {
+	return func(actual, recorded Request) bool {
+		return reflect.DeepEqual(actual.Headers.Values(name), recorded.Headers.Values(name))
+	}
+}
+
+// MatchAll returns a Matcher matching requests matched by all of the given matchers.
+func MatchAll(matchers ...Matcher) Matcher {
+	return func(actual, recorded Request) bool {
+		for _, m := range matchers {
+			if !m(actual, recorded) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
@@ -0,0 +1,274 @@
+// Package linodegotest records interactions with the Linode API to fixture files
+// and replays them, allowing test suites of projects using linodego to run
+// without access to the API. Fixtures use the same format as the fixtures of
+// linodego's own integration tests (version 1 of the go-vcr cassette format).
+package linodegotest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mode determines whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay replays interactions from the fixture file without sending requests.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and records the interactions to the fixture file.
+	ModeRecord
+
+	// ModePassthrough sends requests to the API without recording them.
+	ModePassthrough
+)
+
+// FixtureModeEnvVar is the environment variable read by ModeFromEnv.
+const FixtureModeEnvVar = "LINODE_FIXTURE_MODE"
+
+// ModeFromEnv returns the Mode set by the LINODE_FIXTURE_MODE environment variable:
+// "record" for ModeRecord, "passthrough" for ModePassthrough and ModeReplay otherwise.
+func ModeFromEnv() Mode {
+	switch os.Getenv(FixtureModeEnvVar) {
+	case "record":
+		return ModeRecord
+	case "passthrough":
+		return ModePassthrough
+	default:
+		return ModeReplay
+	}
+}
+
+// Options configure a Recorder.
+type Options struct {
+	// Mode determines whether interactions are recorded or replayed.
+	Mode Mode
+
+	// Transport is used to send requests in ModeRecord and ModePassthrough.
+	// Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Matcher determines which recorded interaction is replayed for a request.
+	// Defaults to MatchMethodAndURL.
+	Matcher Matcher
+
+	// AllowRepeat allows interactions to be replayed more than once. By default, each
+	// interaction is replayed once, in the order the interactions were recorded.
+	AllowRepeat bool
+
+	// Sanitizers are applied to interactions before they are saved,
+	// after the default sanitizers (see DefaultSanitizers).
+	Sanitizers []Sanitizer
+
+	// SkipDefaultSanitizers disables the default sanitizers.
+	SkipDefaultSanitizers bool
+}
+
+// Recorder is an http.RoundTripper recording or replaying interactions with the Linode API.
+type Recorder struct {
+	mu sync.Mutex
+
+	path       string
+	opts       Options
+	cassette   *Cassette
+	sanitizers []Sanitizer
+}
+
+// NewRecorder creates a Recorder for the fixture file at the given path.
+// In ModeReplay, the fixture file must exist.
+func NewRecorder(path string, opts *Options) (*Recorder, error) {
+	r := &Recorder{path: path, cassette: &Cassette{Version: cassetteVersion}}
+
+	if opts != nil {
+		r.opts = *opts
+	}
+
+	if r.opts.Transport == nil {
+		r.opts.Transport = http.DefaultTransport
+	}
+
+	if r.opts.Matcher == nil {
+		r.opts.Matcher = MatchMethodAndURL
+	}
+
+	if !r.opts.SkipDefaultSanitizers {
+		r.sanitizers = DefaultSanitizers()
+	}
+
+	r.sanitizers = append(r.sanitizers, r.opts.Sanitizers...)
+
+	if r.opts.Mode == ModeReplay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+
+		r.cassette = cassette
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.opts.Mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch r.opts.Mode {
+	case ModeReplay:
+		return r.replay(req)
+	case ModeRecord:
+		return r.record(req)
+	default:
+		return r.opts.Transport.RoundTrip(req)
+	}
+}
+
+// Stop saves the recorded interactions in ModeRecord.
+func (r *Recorder) Stop() error {
+	if r.opts.Mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	actual, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recorded requests have been sanitized
+	sanitized := &Interaction{Request: actual, Response: Response{Headers: make(http.Header)}}
+	if err := r.sanitize(sanitized); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	interaction, err := r.cassette.find(sanitized.Request, r.opts.Matcher, r.opts.AllowRepeat)
+	r.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return interaction.Response.toHTTP(req), nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	recorded, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+
+	resp, err := r.opts.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := &Interaction{
+		Request: recorded,
+		Response: Response{
+			Body:     string(body),
+			Headers:  resp.Header.Clone(),
+			Status:   resp.Status,
+			Code:     resp.StatusCode,
+			Duration: time.Since(start).String(),
+		},
+	}
+
+	if err := r.sanitize(interaction); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) sanitize(i *Interaction) error {
+	for _, s := range r.sanitizers {
+		if err := s(i); err != nil {
+			return fmt.Errorf("failed to sanitize interaction: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// newRequest creates a Request from the given request, restoring its body.
+func newRequest(req *http.Request) (Request, error) {
+	result := Request{
+		Headers: req.Header.Clone(),
+		URL:     req.URL.String(),
+		Method:  req.Method,
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return result, err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		result.Body = string(body)
+	}
+
+	if result.Headers == nil {
+		result.Headers = make(http.Header)
+	}
+
+	return result, nil
+}
+
+// toHTTP creates an http.Response for the given request from the recorded response.
+func (r Response) toHTTP(req *http.Request) *http.Response {
+	status := r.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", r.Code, http.StatusText(r.Code))
+	}
+
+	header := r.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        status,
+		StatusCode:    r.Code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,137 @@
+package linodegotest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecordedClient(t *testing.T, recorder *Recorder, baseURL string) *linodego.Client {
+	t.Helper()
+
+	client := linodego.NewClient(&http.Client{Transport: recorder})
+	client.SetToken("secret-token").SetBaseURL(baseURL).SetRetryCount(0)
+
+	return &client
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ratelimit-Remaining", "399")
+
+		switch r.URL.Path {
+		case "/v4/networking/ips/203.0.114.7":
+			_, _ = io.WriteString(w, `{"address": "203.0.114.7", "gateway": "203.0.114.1", "subnet_mask": "255.255.255.0",`+
+				` "rdns": "203-0-114-7.ip.linodeusercontent.com", "linode_id": 123}`)
+		case "/v4/linode/instances":
+			_, _ = io.WriteString(w, `{"id": 123, "label": "test", "ipv6": "2600:3c00::f03c:91ff:fe24:1b2c/128"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"errors": [{"reason": "Not found"}]}`)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fixtures", "session.yaml")
+
+	recorder, err := NewRecorder(path, &Options{Mode: ModeRecord})
+	require.NoError(t, err)
+
+	client := newRecordedClient(t, recorder, server.URL)
+
+	ip, err := client.GetIPAddress(context.Background(), "203.0.114.7")
+	require.NoError(t, err)
+	require.Equal(t, "203.0.114.7", ip.Address, "responses are only sanitized when saved")
+
+	_, err = client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+		Label:    "test",
+		RootPass: "hunter2",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, recorder.Stop())
+
+	cassette, err := LoadCassette(path)
+	require.NoError(t, err)
+	require.Len(t, cassette.Interactions, 2)
+
+	first := cassette.Interactions[0]
+	require.Empty(t, first.Request.Headers.Get("Authorization"))
+	require.Empty(t, first.Response.Headers.Get("X-Ratelimit-Remaining"))
+	require.True(t, strings.HasSuffix(first.Request.URL, "/networking/ips/192.0.2.1"))
+	require.Contains(t, first.Response.Body, `"gateway": "192.0.2.2"`)
+	require.Contains(t, first.Response.Body, `"subnet_mask": "255.255.255.0"`)
+	require.Contains(t, first.Response.Body, `"rdns": "192-0-2-1.ip.linodeusercontent.com"`)
+
+	second := cassette.Interactions[1]
+	require.Contains(t, second.Request.Body, `"root_pass":"`+SanitizedValue+`"`)
+	require.Contains(t, second.Response.Body, `"ipv6": "2001:db8::1/128"`)
+
+	// Replaying doesn't require the server
+	server.Close()
+
+	recorder, err = NewRecorder(path, &Options{Mode: ModeReplay, Matcher: MatchMethodAndPath})
+	require.NoError(t, err)
+
+	client = newRecordedClient(t, recorder, "https://api.linode.com")
+
+	ip, err = client.GetIPAddress(context.Background(), "192.0.2.1")
+	require.NoError(t, err)
+	require.Equal(t, "192.0.2.1", ip.Address)
+	require.Equal(t, 123, ip.LinodeID)
+
+	instance, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+		Label:    "test",
+		RootPass: "another-password",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 123, instance.ID)
+
+	// Each interaction is replayed once by default
+	_, err = client.GetIPAddress(context.Background(), "192.0.2.1")
+	require.ErrorContains(t, err, ErrInteractionNotFound.Error())
+}
+
+func TestRecorder_ReplayFixture(t *testing.T) {
+	recorder, err := NewRecorder("testdata/ipv6_pools.yaml", nil)
+	require.NoError(t, err)
+
+	client := newRecordedClient(t, recorder, "https://api.linode.com")
+	client.SetAPIVersion("v4beta")
+
+	pools, err := client.ListIPv6Pools(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	require.Equal(t, "us-east", pools[0].Region)
+}
+
+func TestMatchers(t *testing.T) {
+	recorded := Request{
+		Method:  http.MethodPost,
+		URL:     "https://api.linode.com/v4/linode/instances",
+		Body:    `{"label": "test", "region": "us-east"}`,
+		Headers: http.Header{"X-Filter": []string{`{"label": "test"}`}},
+	}
+
+	body, err := json.Marshal(map[string]string{"region": "us-east", "label": "test"})
+	require.NoError(t, err)
+
+	actual := recorded
+	actual.URL = "http://localhost/v4/linode/instances?page=1"
+	actual.Body = string(body)
+
+	require.False(t, MatchMethodAndURL(actual, recorded))
+	require.True(t, MatchAll(MatchMethodAndPath, MatchBody, MatchHeader("X-Filter"))(actual, recorded))
+
+	actual.Body = `{"label": "other"}`
+	require.False(t, MatchBody(actual, recorded))
+}
@@ -0,0 +1,210 @@
+package linodegotest
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// SanitizedValue replaces sensitive values in recorded interactions.
+const SanitizedValue = "[SANITIZED]"
+
+// Sanitizer modifies an interaction before it is saved, e.g. to remove secrets.
+// Sanitizers are also applied to requests before they are matched when replaying.
+type Sanitizer func(i *Interaction) error
+
+// DefaultSanitizers returns the sanitizers applied by a Recorder unless
+// Options.SkipDefaultSanitizers is set: SanitizeHeaders, SanitizeSecrets
+// and a sanitizer created using NewIPSanitizer.
+func DefaultSanitizers() []Sanitizer {
+	return []Sanitizer{SanitizeHeaders, SanitizeSecrets, NewIPSanitizer()}
+}
+
+// volatileResponseHeaders are removed from responses to keep fixtures stable between recordings.
+var volatileResponseHeaders = []string{
+	"Date",
+	"Retry-After",
+	"X-Customer-Uuid",
+	"X-Ratelimit-Reset",
+	"X-Ratelimit-Remaining",
+	"X-Spec-Version",
+}
+
+// SanitizeHeaders removes the Authorization header of requests and
+// headers that change between recordings from responses.
+func SanitizeHeaders(i *Interaction) error {
+	i.Request.Headers.Del("Authorization")
+
+	for _, name := range volatileResponseHeaders {
+		i.Response.Headers.Del(name)
+	}
+
+	return nil
+}
+
+// SensitiveFields are the JSON fields replaced by SanitizeSecrets.
+var SensitiveFields = []string{
+	"access_key",
+	"client_secret",
+	"kubeconfig",
+	"password",
+	"root_pass",
+	"secret",
+	"secret_key",
+	"token",
+}
+
+// SanitizeSecrets replaces the string values of SensitiveFields in
+// request and response bodies with SanitizedValue.
+func SanitizeSecrets(i *Interaction) error {
+	return SanitizeFields(SensitiveFields...)(i)
+}
+
+// SanitizeFields returns a Sanitizer replacing the string values of the given
+// JSON fields in request and response bodies with SanitizedValue.
+func SanitizeFields(names ...string) Sanitizer {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = regexp.QuoteMeta(name)
+	}
+
+	pattern := regexp.MustCompile(`("(?:` + strings.Join(quoted, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	replacement := `${1}"` + SanitizedValue + `"`
+
+	return func(i *Interaction) error {
+		i.Request.Body = pattern.ReplaceAllString(i.Request.Body, replacement)
+		i.Response.Body = pattern.ReplaceAllString(i.Response.Body, replacement)
+
+		return nil
+	}
+}
+
+var (
+	ipv4Pattern = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	ipv6Pattern = regexp.MustCompile(`(?i)[0-9a-f]{0,4}(?::[0-9a-f]{0,4}){2,7}`)
+
+	// Reverse DNS names of Linode IP addresses, e.g. 192-0-2-1.ip.linodeusercontent.com
+	rdnsPattern = regexp.MustCompile(`\b(\d{1,3}-\d{1,3}-\d{1,3}-\d{1,3})(\.ip\.linodeusercontent\.com)`)
+
+	// Addresses in these ranges are used as replacements
+	documentationPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("192.0.2.0/24"),
+		netip.MustParsePrefix("198.51.100.0/24"),
+		netip.MustParsePrefix("203.0.113.0/24"),
+		netip.MustParsePrefix("2001:db8::/32"),
+	}
+)
+
+// ipSanitizer consistently replaces public IP addresses with addresses reserved for documentation.
+type ipSanitizer struct {
+	mu sync.Mutex
+
+	replacements map[netip.Addr]netip.Addr
+	v4, v6       int
+}
+
+// NewIPSanitizer returns a Sanitizer replacing public IP addresses in request URLs and
+// bodies and response bodies with addresses reserved for documentation (RFC 5737 and
+// RFC 3849). Each address is consistently replaced by the same address, so requests
+// made using addresses from replayed responses match the recorded requests.
+func NewIPSanitizer() Sanitizer {
+	s := &ipSanitizer{replacements: make(map[netip.Addr]netip.Addr)}
+	return s.sanitize
+}
+
+func (s *ipSanitizer) sanitize(i *Interaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, value := range []*string{&i.Request.URL, &i.Request.Body, &i.Response.Body} {
+		if err := s.replaceAll(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ipSanitizer) replaceAll(value *string) error {
+	var err error
+
+	replace := func(match string) string {
+		addr, parseErr := netip.ParseAddr(match)
+		if parseErr != nil || !shouldSanitize(addr) {
+			return match
+		}
+
+		replacement, replaceErr := s.replacement(addr)
+		if replaceErr != nil {
+			err = replaceErr
+			return match
+		}
+
+		return replacement.String()
+	}
+
+	result := rdnsPattern.ReplaceAllStringFunc(*value, func(match string) string {
+		groups := rdnsPattern.FindStringSubmatch(match)
+		return strings.ReplaceAll(replace(strings.ReplaceAll(groups[1], "-", ".")), ".", "-") + groups[2]
+	})
+	result = ipv4Pattern.ReplaceAllStringFunc(result, replace)
+	result = ipv6Pattern.ReplaceAllStringFunc(result, replace)
+
+	*value = result
+
+	return err
+}
+
+// replacement returns the address replacing the given address.
+func (s *ipSanitizer) replacement(addr netip.Addr) (netip.Addr, error) {
+	if replacement, ok := s.replacements[addr]; ok {
+		return replacement, nil
+	}
+
+	var replacement netip.Addr
+
+	if addr.Is4() {
+		prefixIndex, host := s.v4/254, s.v4%254+1
+		if prefixIndex >= 3 {
+			return addr, fmt.Errorf("too many IPv4 addresses to sanitize")
+		}
+
+		base := documentationPrefixes[prefixIndex].Addr().As4()
+		base[3] = byte(host)
+		replacement = netip.AddrFrom4(base)
+		s.v4++
+	} else {
+		s.v6++
+		base := documentationPrefixes[3].Addr().As16()
+		base[14], base[15] = byte(s.v6>>8), byte(s.v6)
+		replacement = netip.AddrFrom16(base)
+	}
+
+	s.replacements[addr] = replacement
+
+	return replacement, nil
+}
+
+// shouldSanitize returns whether the given address may identify a resource.
+func shouldSanitize(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	// Subnet masks, e.g. 255.255.255.0
+	if addr.Is4() && addr.As4()[0] == 255 {
+		return false
+	}
+
+	for _, prefix := range documentationPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
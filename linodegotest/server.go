@@ -0,0 +1,627 @@
+package linodegotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	defaultTransitionDelay = 100 * time.Millisecond
+
+	defaultPageSize = 100
+	minPageSize     = 25
+	maxPageSize     = 500
+
+	// timestampFormat is the format of timestamps returned by the API.
+	timestampFormat = "2006-01-02T15:04:05"
+)
+
+// ServerOptions configure a Server.
+type ServerOptions struct {
+	// TransitionDelay is the time between the steps of long-running operations,
+	// e.g. between the provisioning, booting and running statuses of a new instance.
+	// Defaults to 100 milliseconds.
+	TransitionDelay time.Duration
+}
+
+// Server is a fake Linode API keeping the state of the most commonly used resources in
+// memory: instances with their configs and disks, volumes, domains with their records,
+// firewalls, NodeBalancers with their configs and nodes, VPCs with their subnets, tags
+// and events.
+//
+// List endpoints support pagination and the X-Filter header. Long-running operations,
+// such as creating or booting an instance, move through the same statuses as they do
+// using the API and record events, so the WaitFor functions and EventPoller of linodego
+// can be used against the server. Endpoints of other resources respond with 404 Not Found.
+type Server struct {
+	// URL is the base URL of the server, e.g. http://127.0.0.1:1234.
+	URL string
+
+	server *httptest.Server
+	opts   ServerOptions
+
+	mu          sync.Mutex
+	lastID      int
+	addresses   int
+	collections map[string]*collection
+	tags        map[string]bool
+	transitions []transition
+}
+
+// NewServer starts a Server. It must be closed using Close once it is no longer used.
+func NewServer(opts *ServerOptions) *Server {
+	s := &Server{
+		collections: make(map[string]*collection),
+		tags:        make(map[string]bool),
+	}
+
+	if opts != nil {
+		s.opts = *opts
+	}
+
+	if s.opts.TransitionDelay <= 0 {
+		s.opts.TransitionDelay = defaultTransitionDelay
+	}
+
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+
+	s.server = httptest.NewServer(authenticate(mux))
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a new linodego.Client sending requests to the server.
+// The client polls for status changes four times per TransitionDelay.
+func (s *Server) Client() *linodego.Client {
+	client := linodego.NewClient(s.server.Client())
+	client.SetBaseURL(s.URL).
+		SetToken(replayToken).
+		SetPollDelay(s.opts.TransitionDelay / 4)
+
+	return &client
+}
+
+// Settle completes all pending long-running operations immediately.
+func (s *Server) Settle() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Applying transitions may schedule further ones, so repeat until none remain
+	for len(s.transitions) > 0 {
+		latest := s.transitions[0].at
+		for _, t := range s.transitions[1:] {
+			if t.at.After(latest) {
+				latest = t.at
+			}
+		}
+
+		s.applyTransitions(latest)
+	}
+}
+
+// object is a resource as represented in request and response bodies.
+type object = map[string]any
+
+// collection holds the objects of a resource in the order they were created.
+type collection struct {
+	ids     []int
+	objects map[int]object
+}
+
+func (c *collection) get(id int) object {
+	return c.objects[id]
+}
+
+func (c *collection) insert(obj object) {
+	id := obj["id"].(int)
+
+	c.ids = append(c.ids, id)
+	c.objects[id] = obj
+}
+
+func (c *collection) remove(id int) {
+	c.ids = slices.DeleteFunc(c.ids, func(i int) bool { return i == id })
+	delete(c.objects, id)
+}
+
+func (c *collection) list() []object {
+	result := make([]object, len(c.ids))
+	for i, id := range c.ids {
+		result[i] = c.objects[id]
+	}
+
+	return result
+}
+
+// collection returns the collection with the given key, e.g. linode/instances/123/disks.
+func (s *Server) collection(key string) *collection {
+	c, ok := s.collections[key]
+	if !ok {
+		c = &collection{objects: make(map[int]object)}
+		s.collections[key] = c
+	}
+
+	return c
+}
+
+// nextID returns the ID of a new object. IDs are unique across all collections.
+func (s *Server) nextID() int {
+	s.lastID++
+	return s.lastID
+}
+
+// nextIPv4 returns a new public IPv4 address from the ranges reserved for documentation.
+func (s *Server) nextIPv4() string {
+	s.addresses++
+
+	base := documentationPrefixes[(s.addresses/254)%3].Addr().As4()
+	base[3] = byte(s.addresses%254 + 1)
+
+	return netip.AddrFrom4(base).String()
+}
+
+// nextIPv6 returns a new IPv6 address from the range reserved for documentation.
+func (s *Server) nextIPv6() string {
+	s.addresses++
+
+	base := documentationPrefixes[3].Addr().As16()
+	base[14], base[15] = byte(s.addresses>>8), byte(s.addresses)
+
+	return netip.AddrFrom16(base).String()
+}
+
+// transition is a step of a long-running operation applied once it is due.
+type transition struct {
+	at    time.Time
+	apply func()
+}
+
+// after schedules the given step to be applied after the given number of transition delays.
+func (s *Server) after(steps int, apply func()) {
+	s.transitions = append(s.transitions, transition{
+		at:    time.Now().Add(time.Duration(steps) * s.opts.TransitionDelay),
+		apply: apply,
+	})
+}
+
+// applyTransitions applies all steps due at the given time in the order they are due.
+func (s *Server) applyTransitions(until time.Time) {
+	sort.SliceStable(s.transitions, func(i, j int) bool {
+		return s.transitions[i].at.Before(s.transitions[j].at)
+	})
+
+	applied := 0
+	for ; applied < len(s.transitions) && !s.transitions[applied].at.After(until); applied++ {
+		s.transitions[applied].apply()
+	}
+
+	s.transitions = s.transitions[applied:]
+}
+
+// apiError is an error response of the server.
+type apiError struct {
+	status int
+	field  string
+	reason string
+}
+
+func (e *apiError) Error() string {
+	return e.reason
+}
+
+func errNotFound() error {
+	return &apiError{status: http.StatusNotFound, reason: "Not found"}
+}
+
+func errBadRequest(field, reason string) error {
+	return &apiError{status: http.StatusBadRequest, field: field, reason: reason}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = &apiError{status: http.StatusInternalServerError, reason: err.Error()}
+	}
+
+	data, _ := json.Marshal(linodego.APIError{
+		Errors: []linodego.APIErrorReason{{Reason: apiErr.reason, Field: apiErr.field}},
+	})
+
+	writeJSON(w, apiErr.status, data)
+}
+
+// authenticate responds with 401 Unauthorized to requests without a token.
+func authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			writeError(w, &apiError{status: http.StatusUnauthorized, reason: "Invalid Token"})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handlerFunc handles a request, returning the object to respond with.
+type handlerFunc func(r *http.Request) (any, error)
+
+// handle registers a handler for the given pattern. The pattern is prefixed with the
+// API version, and handlers are called with the state of the server locked after
+// applying all transitions due.
+func (s *Server) handle(mux *http.ServeMux, method, path string, h handlerFunc) {
+	mux.HandleFunc(method+" /{version}/"+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.applyTransitions(time.Now())
+
+		result, err := h(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		// Responses are encoded while locked, as transitions modify objects
+		data, err := json.Marshal(result)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, data)
+	})
+}
+
+// decodeBody decodes the JSON object in the body of the given request, if any.
+func decodeBody(r *http.Request) (object, error) {
+	body := make(object)
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return body, nil
+	}
+
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, errBadRequest("", "Invalid JSON")
+	}
+
+	return body, nil
+}
+
+// filterObjects returns the objects matching the given value of the X-Filter header,
+// ordered by the +order_by and +order fields of the filter.
+func filterObjects(header string, objects []object) ([]object, error) {
+	if header == "" {
+		return objects, nil
+	}
+
+	if !json.Valid([]byte(header)) {
+		return nil, errBadRequest("X-Filter", "Invalid JSON")
+	}
+
+	var filter linodego.Filter
+	if err := json.Unmarshal([]byte(header), &filter); err != nil {
+		return nil, errBadRequest("X-Filter", err.Error())
+	}
+
+	result, err := linodego.FilterResults(&filter, objects)
+	if err != nil {
+		return nil, errBadRequest("X-Filter", err.Error())
+	}
+
+	return result, nil
+}
+
+// list responds with a page of the given objects matching the X-Filter header of the request.
+func list(r *http.Request, objects []object) (any, error) {
+	objects, err := filterObjects(r.Header.Get("X-Filter"), objects)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := 1, defaultPageSize
+
+	if value := r.URL.Query().Get("page"); value != "" {
+		if page, err = strconv.Atoi(value); err != nil || page < 1 {
+			return nil, errBadRequest("page", "Must be a positive integer")
+		}
+	}
+
+	if value := r.URL.Query().Get("page_size"); value != "" {
+		if pageSize, err = strconv.Atoi(value); err != nil || pageSize < minPageSize || pageSize > maxPageSize {
+			return nil, errBadRequest("page_size", fmt.Sprintf("Must be between %d and %d", minPageSize, maxPageSize))
+		}
+	}
+
+	start := min((page-1)*pageSize, len(objects))
+	end := min(start+pageSize, len(objects))
+
+	return object{
+		"data":    append([]object{}, objects[start:end]...),
+		"page":    page,
+		"pages":   max(1, (len(objects)+pageSize-1)/pageSize),
+		"results": len(objects),
+	}, nil
+}
+
+// resource describes a collection of objects managed using the CRUD endpoints of the API.
+type resource struct {
+	// path is the path of the collection, with wildcards for the IDs of the
+	// objects owning the collection, e.g. linode/instances/{linodeID}/disks.
+	path string
+
+	// fields returns the fields of a new object which may be set in request bodies,
+	// with their default values. Other fields of request bodies are ignored.
+	fields func(id int) object
+
+	// readOnly are the fields which may only be set when creating an object.
+	readOnly []string
+
+	// create is called before a new object is added, e.g. to validate it, set
+	// its computed fields or start a long-running operation.
+	create func(parent, obj, body object) error
+
+	// deleted is called after an object has been deleted.
+	deleted func(parent, obj object)
+}
+
+// register registers the list, create, get, update and delete endpoints of the given resource.
+func (s *Server) register(mux *http.ServeMux, res resource) {
+	item := res.path + "/{id}"
+
+	s.handle(mux, http.MethodGet, res.path, func(r *http.Request) (any, error) {
+		key, _, err := s.resolve(res.path, r)
+		if err != nil {
+			return nil, err
+		}
+
+		return list(r, s.collection(key).list())
+	})
+
+	s.handle(mux, http.MethodPost, res.path, func(r *http.Request) (any, error) {
+		key, parent, err := s.resolve(res.path, r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.create(res, key, parent, body)
+	})
+
+	s.handle(mux, http.MethodGet, item, func(r *http.Request) (any, error) {
+		_, _, obj, err := s.lookup(res.path, r)
+		return obj, err
+	})
+
+	s.handle(mux, http.MethodPut, item, func(r *http.Request) (any, error) {
+		_, _, obj, err := s.lookup(res.path, r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		for field := range res.fields(obj["id"].(int)) {
+			if value, ok := body[field]; ok && !slices.Contains(res.readOnly, field) {
+				obj[field] = value
+			}
+		}
+
+		obj["updated"] = timestamp()
+
+		return obj, nil
+	})
+
+	s.handle(mux, http.MethodDelete, item, func(r *http.Request) (any, error) {
+		key, parent, obj, err := s.lookup(res.path, r)
+		if err != nil {
+			return nil, err
+		}
+
+		s.delete(key, obj)
+
+		if res.deleted != nil {
+			res.deleted(parent, obj)
+		}
+
+		return object{}, nil
+	})
+}
+
+// create adds a new object with the given fields to the collection with the given key.
+func (s *Server) create(res resource, key string, parent, body object) (object, error) {
+	id := s.nextID()
+	now := timestamp()
+
+	obj := object{"id": id, "created": now, "updated": now}
+
+	for field, value := range res.fields(id) {
+		if bodyValue, ok := body[field]; ok {
+			value = bodyValue
+		}
+
+		obj[field] = value
+	}
+
+	if res.create != nil {
+		if err := res.create(parent, obj, body); err != nil {
+			return nil, err
+		}
+	}
+
+	s.collection(key).insert(obj)
+
+	return obj, nil
+}
+
+// delete removes the given object from the collection with the given key,
+// along with the collections it owns.
+func (s *Server) delete(key string, obj object) {
+	s.collection(key).remove(obj["id"].(int))
+
+	prefix := fmt.Sprintf("%s/%d/", key, obj["id"])
+	for k := range s.collections {
+		if strings.HasPrefix(k, prefix) {
+			delete(s.collections, k)
+		}
+	}
+}
+
+// resolve returns the key of the collection at the given path for the given request,
+// along with the object owning it, if any.
+func (s *Server) resolve(path string, r *http.Request) (string, object, error) {
+	var parent object
+
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, "{")
+		if !ok {
+			continue
+		}
+
+		id, err := strconv.Atoi(r.PathValue(strings.TrimSuffix(name, "}")))
+		if err != nil {
+			return "", nil, errNotFound()
+		}
+
+		if parent = s.collection(strings.Join(segments[:i], "/")).get(id); parent == nil {
+			return "", nil, errNotFound()
+		}
+
+		segments[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(segments, "/"), parent, nil
+}
+
+// lookup returns the object with the ID in the path of the given request,
+// along with the key of its collection and the object owning it, if any.
+func (s *Server) lookup(path string, r *http.Request) (string, object, object, error) {
+	key, parent, err := s.resolve(path, r)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return "", nil, nil, errNotFound()
+	}
+
+	obj := s.collection(key).get(id)
+	if obj == nil {
+		return "", nil, nil, errNotFound()
+	}
+
+	return key, parent, obj, nil
+}
+
+// event records an event with the given status for the given entities.
+func (s *Server) event(action linodego.EventAction, entity, secondaryEntity object, status linodego.EventStatus) object {
+	event := object{
+		"id":               s.nextID(),
+		"action":           action,
+		"created":          timestamp(),
+		"duration":         0,
+		"entity":           entity,
+		"secondary_entity": secondaryEntity,
+		"message":          nil,
+		"percent_complete": 0,
+		"rate":             nil,
+		"read":             false,
+		"seen":             false,
+		"status":           status,
+		"time_remaining":   nil,
+		"username":         "linodegotest",
+	}
+
+	if status == linodego.EventFinished {
+		event["percent_complete"] = 100
+	}
+
+	s.collection(eventsPath).insert(event)
+
+	return event
+}
+
+// finish marks the given event as finished.
+func finish(event object) {
+	event["status"] = linodego.EventFinished
+	event["percent_complete"] = 100
+}
+
+// entity returns the entity of an event referring to the given object.
+func entity(entityType linodego.EntityType, path string, obj object, labelField string) object {
+	return object{
+		"id":    obj["id"],
+		"type":  entityType,
+		"label": obj[labelField],
+		"url":   fmt.Sprintf("/v4/%s/%d", path, obj["id"]),
+	}
+}
+
+// requireFields returns an error if any of the given fields of the object is empty.
+func requireFields(obj object, fields ...string) error {
+	for _, field := range fields {
+		if value, ok := obj[field]; !ok || value == nil || value == "" {
+			return errBadRequest(field, field+" is required")
+		}
+	}
+
+	return nil
+}
+
+// toInt returns the integer value of a number decoded from a request body.
+func toInt(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), v == math.Trunc(v)
+	default:
+		return 0, false
+	}
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format(timestampFormat)
+}
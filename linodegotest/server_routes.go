@@ -0,0 +1,868 @@
+package linodegotest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	instancesPath     = "linode/instances"
+	volumesPath       = "volumes"
+	domainsPath       = "domains"
+	firewallsPath     = "networking/firewalls"
+	nodeBalancersPath = "nodebalancers"
+	vpcsPath          = "vpcs"
+	eventsPath        = "account/events"
+)
+
+// taggedTypes are the types of objects which may be tagged, along with the
+// fields of tag create requests listing their IDs and their collections.
+var taggedTypes = []struct {
+	name  string
+	field string
+	path  string
+}{
+	{"linode", "linodes", instancesPath},
+	{"domain", "domains", domainsPath},
+	{"volume", "volumes", volumesPath},
+	{"nodebalancer", "nodebalancers", nodeBalancersPath},
+}
+
+func (s *Server) registerRoutes(mux *http.ServeMux) {
+	s.registerInstances(mux)
+	s.registerVolumes(mux)
+	s.registerDomains(mux)
+	s.registerFirewalls(mux)
+	s.registerNodeBalancers(mux)
+	s.registerVPCs(mux)
+	s.registerTags(mux)
+	s.registerEvents(mux)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, errNotFound())
+	})
+}
+
+func (s *Server) registerInstances(mux *http.ServeMux) {
+	s.register(mux, resource{
+		path: instancesPath,
+		fields: func(id int) object {
+			return object{
+				"label":            fmt.Sprintf("linode%d", id),
+				"region":           "",
+				"type":             "",
+				"image":            nil,
+				"group":            "",
+				"tags":             []any{},
+				"watchdog_enabled": true,
+			}
+		},
+		readOnly: []string{"region", "type", "image"},
+		create: func(_, instance, body object) error {
+			if err := requireFields(instance, "region", "type"); err != nil {
+				return err
+			}
+
+			instance["status"] = linodego.InstanceProvisioning
+			instance["hypervisor"] = "kvm"
+			instance["ipv4"] = []string{s.nextIPv4()}
+			instance["ipv6"] = s.nextIPv6() + "/128"
+			instance["specs"] = object{"disk": 0, "memory": 0, "vcpus": 0, "transfer": 0, "gpus": 0}
+			instance["backups"] = object{"enabled": false, "available": false}
+
+			booted := instance["image"] != nil
+			if value, ok := body["booted"].(bool); ok {
+				booted = value
+			}
+
+			if instance["image"] != nil {
+				s.createInstanceDisks(instance)
+			}
+
+			event := s.event(linodego.ActionLinodeCreate, instanceEntity(instance), nil, linodego.EventStarted)
+
+			s.after(1, func() {
+				finish(event)
+
+				if booted {
+					s.instanceAction(instance, linodego.ActionLinodeBoot, linodego.InstanceBooting, linodego.InstanceRunning)
+				} else {
+					instance["status"] = linodego.InstanceOffline
+				}
+			})
+
+			return nil
+		},
+		deleted: func(_, instance object) {
+			for _, volume := range s.collection(volumesPath).list() {
+				if id, _ := toInt(volume["linode_id"]); id == instance["id"] {
+					volume["linode_id"] = nil
+				}
+			}
+
+			s.event(linodego.ActionLinodeDelete, instanceEntity(instance), nil, linodego.EventFinished)
+		},
+	})
+
+	for _, action := range []struct {
+		path          string
+		action        linodego.EventAction
+		status, final linodego.InstanceStatus
+	}{
+		{"boot", linodego.ActionLinodeBoot, linodego.InstanceBooting, linodego.InstanceRunning},
+		{"reboot", linodego.ActionLinodeReboot, linodego.InstanceRebooting, linodego.InstanceRunning},
+		{"shutdown", linodego.ActionLinodeShutdown, linodego.InstanceShuttingDown, linodego.InstanceOffline},
+	} {
+		s.handle(mux, http.MethodPost, instancesPath+"/{id}/"+action.path, func(r *http.Request) (any, error) {
+			_, _, instance, err := s.lookup(instancesPath, r)
+			if err != nil {
+				return nil, err
+			}
+
+			s.instanceAction(instance, action.action, action.status, action.final)
+
+			return object{}, nil
+		})
+	}
+
+	s.register(mux, resource{
+		path: instancesPath + "/{linodeID}/configs",
+		fields: func(int) object {
+			return object{
+				"label":        "",
+				"comments":     "",
+				"devices":      object{},
+				"helpers":      object{},
+				"interfaces":   []any{},
+				"kernel":       "linode/grub2",
+				"memory_limit": 0,
+				"root_device":  "/dev/sda",
+				"run_level":    "default",
+				"virt_mode":    "paravirt",
+			}
+		},
+		create: func(instance, config, _ object) error {
+			if err := requireFields(config, "label"); err != nil {
+				return err
+			}
+
+			s.event(linodego.ActionLinodeConfigCreate, instanceEntity(instance), nil, linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(instance, _ object) {
+			s.event(linodego.ActionLinodeConfigDelete, instanceEntity(instance), nil, linodego.EventFinished)
+		},
+	})
+
+	s.register(mux, resource{
+		path: instancesPath + "/{linodeID}/disks",
+		fields: func(int) object {
+			return object{
+				"label":      "",
+				"size":       0,
+				"filesystem": "ext4",
+			}
+		},
+		readOnly: []string{"size", "filesystem"},
+		create: func(instance, disk, _ object) error {
+			if err := requireFields(disk, "label", "size"); err != nil {
+				return err
+			}
+
+			disk["status"] = linodego.DiskNotReady
+
+			secondary := entity(linodego.EntityDisk, fmt.Sprintf("%s/%d/disks", instancesPath, instance["id"]), disk, "label")
+			event := s.event(linodego.ActionDiskCreate, instanceEntity(instance), secondary, linodego.EventStarted)
+
+			s.after(1, func() {
+				disk["status"] = linodego.DiskReady
+				finish(event)
+			})
+
+			return nil
+		},
+		deleted: func(instance, disk object) {
+			secondary := entity(linodego.EntityDisk, fmt.Sprintf("%s/%d/disks", instancesPath, instance["id"]), disk, "label")
+			s.event(linodego.ActionDiskDelete, instanceEntity(instance), secondary, linodego.EventFinished)
+		},
+	})
+}
+
+// instanceAction moves the given instance to the given status, and to the final status
+// once the event recorded for the action has finished.
+func (s *Server) instanceAction(instance object, action linodego.EventAction, status, final linodego.InstanceStatus) {
+	instance["status"] = status
+
+	event := s.event(action, instanceEntity(instance), nil, linodego.EventStarted)
+
+	s.after(1, func() {
+		instance["status"] = final
+		finish(event)
+	})
+}
+
+// createInstanceDisks creates the disks and config of an instance deployed from an image.
+func (s *Server) createInstanceDisks(instance object) {
+	key := fmt.Sprintf("%s/%d", instancesPath, instance["id"])
+	now := timestamp()
+
+	disk := func(label, filesystem string, size int) object {
+		disk := object{
+			"id":         s.nextID(),
+			"label":      label,
+			"filesystem": filesystem,
+			"size":       size,
+			"status":     linodego.DiskNotReady,
+			"created":    now,
+			"updated":    now,
+		}
+
+		s.collection(key + "/disks").insert(disk)
+		s.after(1, func() { disk["status"] = linodego.DiskReady })
+
+		return disk
+	}
+
+	root := disk(fmt.Sprintf("%s Disk", instance["image"]), "ext4", 25088)
+	swap := disk("512 MB Swap Image", "swap", 512)
+
+	s.collection(key + "/configs").insert(object{
+		"id":    s.nextID(),
+		"label": fmt.Sprintf("My %s Disk Profile", instance["image"]),
+		"devices": object{
+			"sda": object{"disk_id": root["id"]},
+			"sdb": object{"disk_id": swap["id"]},
+		},
+		"helpers":      object{},
+		"interfaces":   []any{},
+		"kernel":       "linode/grub2",
+		"memory_limit": 0,
+		"root_device":  "/dev/sda",
+		"run_level":    "default",
+		"virt_mode":    "paravirt",
+		"created":      now,
+		"updated":      now,
+	})
+}
+
+func instanceEntity(instance object) object {
+	return entity(linodego.EntityLinode, instancesPath, instance, "label")
+}
+
+func (s *Server) registerVolumes(mux *http.ServeMux) {
+	s.register(mux, resource{
+		path: volumesPath,
+		fields: func(id int) object {
+			return object{
+				"label":     fmt.Sprintf("volume%d", id),
+				"region":    "",
+				"size":      20,
+				"linode_id": nil,
+				"tags":      []any{},
+			}
+		},
+		readOnly: []string{"region", "size", "linode_id"},
+		create: func(_, volume, _ object) error {
+			if volume["linode_id"] != nil {
+				instance, err := s.instance(volume["linode_id"])
+				if err != nil {
+					return err
+				}
+
+				volume["linode_id"] = instance["id"]
+				if volume["region"] == "" {
+					volume["region"] = instance["region"]
+				}
+			}
+
+			if err := requireFields(volume, "region"); err != nil {
+				return err
+			}
+
+			volume["status"] = linodego.VolumeCreating
+			volume["filesystem_path"] = fmt.Sprintf("/dev/disk/by-id/scsi-0Linode_Volume_%s", volume["label"])
+			volume["hardware_type"] = "nvme"
+
+			event := s.event(linodego.ActionVolumeCreate, volumeEntity(volume), nil, linodego.EventStarted)
+
+			s.after(1, func() {
+				volume["status"] = linodego.VolumeActive
+				finish(event)
+			})
+
+			return nil
+		},
+		deleted: func(_, volume object) {
+			s.event(linodego.ActionVolumeDelete, volumeEntity(volume), nil, linodego.EventFinished)
+		},
+	})
+
+	s.handle(mux, http.MethodPost, volumesPath+"/{id}/attach", func(r *http.Request) (any, error) {
+		_, _, volume, err := s.lookup(volumesPath, r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		instance, err := s.instance(body["linode_id"])
+		if err != nil {
+			return nil, err
+		}
+
+		if volume["linode_id"] != nil {
+			return nil, errBadRequest("linode_id", "Volume is already attached")
+		}
+
+		event := s.event(linodego.ActionVolumeAttach, volumeEntity(volume), nil, linodego.EventStarted)
+
+		s.after(1, func() {
+			volume["linode_id"] = instance["id"]
+			finish(event)
+		})
+
+		return volume, nil
+	})
+
+	s.handle(mux, http.MethodPost, volumesPath+"/{id}/detach", func(r *http.Request) (any, error) {
+		_, _, volume, err := s.lookup(volumesPath, r)
+		if err != nil {
+			return nil, err
+		}
+
+		event := s.event(linodego.ActionVolumeDetach, volumeEntity(volume), nil, linodego.EventStarted)
+
+		s.after(1, func() {
+			volume["linode_id"] = nil
+			finish(event)
+		})
+
+		return object{}, nil
+	})
+}
+
+// instance returns the instance with the given ID from a request body.
+func (s *Server) instance(id any) (object, error) {
+	linodeID, ok := toInt(id)
+	if !ok {
+		return nil, errBadRequest("linode_id", "linode_id is required")
+	}
+
+	instance := s.collection(instancesPath).get(linodeID)
+	if instance == nil {
+		return nil, errBadRequest("linode_id", "Linode not found")
+	}
+
+	return instance, nil
+}
+
+func volumeEntity(volume object) object {
+	return entity(linodego.EntityVolume, volumesPath, volume, "label")
+}
+
+func (s *Server) registerDomains(mux *http.ServeMux) {
+	s.register(mux, resource{
+		path: domainsPath,
+		fields: func(int) object {
+			return object{
+				"domain":      "",
+				"type":        linodego.DomainTypeMaster,
+				"soa_email":   "",
+				"description": "",
+				"group":       "",
+				"status":      linodego.DomainStatusActive,
+				"tags":        []any{},
+				"master_ips":  []any{},
+				"axfr_ips":    []any{},
+				"ttl_sec":     0,
+				"retry_sec":   0,
+				"expire_sec":  0,
+				"refresh_sec": 0,
+			}
+		},
+		readOnly: []string{"domain"},
+		create: func(_, domain, _ object) error {
+			if err := requireFields(domain, "domain"); err != nil {
+				return err
+			}
+
+			if fmt.Sprint(domain["type"]) == string(linodego.DomainTypeMaster) {
+				if err := requireFields(domain, "soa_email"); err != nil {
+					return err
+				}
+			}
+
+			s.event("domain_create", domainEntity(domain), nil, linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(_, domain object) {
+			s.event("domain_delete", domainEntity(domain), nil, linodego.EventFinished)
+		},
+	})
+
+	s.register(mux, resource{
+		path: domainsPath + "/{domainID}/records",
+		fields: func(int) object {
+			return object{
+				"type":     "",
+				"name":     "",
+				"target":   "",
+				"priority": 0,
+				"weight":   0,
+				"port":     0,
+				"service":  nil,
+				"protocol": nil,
+				"ttl_sec":  0,
+				"tag":      nil,
+			}
+		},
+		readOnly: []string{"type"},
+		create: func(domain, record, _ object) error {
+			if err := requireFields(record, "type"); err != nil {
+				return err
+			}
+
+			s.event("domain_record_create", domainEntity(domain), nil, linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(domain, _ object) {
+			s.event("domain_record_delete", domainEntity(domain), nil, linodego.EventFinished)
+		},
+	})
+}
+
+func domainEntity(domain object) object {
+	return entity(linodego.EntityDomain, domainsPath, domain, "domain")
+}
+
+func (s *Server) registerFirewalls(mux *http.ServeMux) {
+	s.register(mux, resource{
+		path: firewallsPath,
+		fields: func(int) object {
+			return object{
+				"label":  "",
+				"status": linodego.FirewallEnabled,
+				"tags":   []any{},
+				"rules": object{
+					"inbound":         []any{},
+					"inbound_policy":  "ACCEPT",
+					"outbound":        []any{},
+					"outbound_policy": "ACCEPT",
+				},
+			}
+		},
+		create: func(_, firewall, body object) error {
+			if err := requireFields(firewall, "label"); err != nil {
+				return err
+			}
+
+			devices, _ := body["devices"].(map[string]any)
+			key := fmt.Sprintf("%s/%d/devices", firewallsPath, firewall["id"])
+
+			for field, deviceType := range map[string]string{"linodes": "linode", "nodebalancers": "nodebalancer"} {
+				ids, _ := devices[field].([]any)
+				for _, id := range ids {
+					if _, err := s.create(s.firewallDevices(), key, firewall, object{"id": id, "type": deviceType}); err != nil {
+						return err
+					}
+				}
+			}
+
+			s.event(linodego.ActionFirewallCreate, firewallEntity(firewall), nil, linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(_, firewall object) {
+			s.event(linodego.ActionFirewallDelete, firewallEntity(firewall), nil, linodego.EventFinished)
+		},
+	})
+
+	rules := firewallsPath + "/{id}/rules"
+
+	s.handle(mux, http.MethodGet, rules, func(r *http.Request) (any, error) {
+		_, _, firewall, err := s.lookup(firewallsPath, r)
+		if err != nil {
+			return nil, err
+		}
+
+		return firewall["rules"], nil
+	})
+
+	s.handle(mux, http.MethodPut, rules, func(r *http.Request) (any, error) {
+		_, _, firewall, err := s.lookup(firewallsPath, r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		firewall["rules"] = body
+		firewall["updated"] = timestamp()
+
+		s.event(linodego.ActionFirewallUpdate, firewallEntity(firewall), nil, linodego.EventFinished)
+
+		return body, nil
+	})
+
+	s.register(mux, s.firewallDevices())
+}
+
+// firewallDevices is the resource of the devices of a firewall.
+func (s *Server) firewallDevices() resource {
+	return resource{
+		path:   firewallsPath + "/{firewallID}/devices",
+		fields: func(int) object { return object{} },
+		create: func(firewall, device, body object) error {
+			deviceType, _ := body["type"].(string)
+
+			path, ok := map[string]string{"linode": instancesPath, "nodebalancer": nodeBalancersPath}[deviceType]
+			if !ok {
+				return errBadRequest("type", "type must be linode or nodebalancer")
+			}
+
+			id, _ := toInt(body["id"])
+
+			obj := s.collection(path).get(id)
+			if obj == nil {
+				return errBadRequest("id", "Not found")
+			}
+
+			device["entity"] = entity(linodego.EntityType(deviceType), path, obj, "label")
+
+			s.event(linodego.ActionFirewallDeviceAdd, firewallEntity(firewall), device["entity"].(object), linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(firewall, device object) {
+			s.event(linodego.ActionFirewallDeviceRemove, firewallEntity(firewall), device["entity"].(object), linodego.EventFinished)
+		},
+	}
+}
+
+func firewallEntity(firewall object) object {
+	return entity(linodego.EntityFirewall, firewallsPath, firewall, "label")
+}
+
+func (s *Server) registerNodeBalancers(mux *http.ServeMux) {
+	s.register(mux, resource{
+		path: nodeBalancersPath,
+		fields: func(id int) object {
+			return object{
+				"label":                fmt.Sprintf("nodebalancer%d", id),
+				"region":               "",
+				"client_conn_throttle": 0,
+				"tags":                 []any{},
+			}
+		},
+		readOnly: []string{"region"},
+		create: func(_, nodeBalancer, _ object) error {
+			if err := requireFields(nodeBalancer, "region"); err != nil {
+				return err
+			}
+
+			nodeBalancer["ipv4"] = s.nextIPv4()
+			nodeBalancer["ipv6"] = s.nextIPv6()
+			nodeBalancer["hostname"] = fmt.Sprintf("nb-%d.%s.nodebalancer.linode.com", nodeBalancer["id"], nodeBalancer["region"])
+			nodeBalancer["transfer"] = object{"in": nil, "out": nil, "total": nil}
+
+			s.event(linodego.ActionNodebalancerCreate, nodeBalancerEntity(nodeBalancer), nil, linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(_, nodeBalancer object) {
+			s.event(linodego.ActionNodebalancerDelete, nodeBalancerEntity(nodeBalancer), nil, linodego.EventFinished)
+		},
+	})
+
+	s.register(mux, resource{
+		path: nodeBalancersPath + "/{nodeBalancerID}/configs",
+		fields: func(int) object {
+			return object{
+				"port":            80,
+				"protocol":        linodego.ProtocolHTTP,
+				"proxy_protocol":  linodego.ProxyProtocolNone,
+				"algorithm":       linodego.AlgorithmRoundRobin,
+				"stickiness":      linodego.StickinessNone,
+				"check":           linodego.CheckNone,
+				"check_interval":  0,
+				"check_attempts":  3,
+				"check_path":      "",
+				"check_body":      "",
+				"check_passive":   true,
+				"check_timeout":   30,
+				"cipher_suite":    linodego.CipherRecommended,
+				"ssl_cert":        "",
+				"ssl_key":         "",
+				"ssl_commonname":  "",
+				"ssl_fingerprint": "",
+			}
+		},
+		create: func(nodeBalancer, config, _ object) error {
+			config["nodebalancer_id"] = nodeBalancer["id"]
+			config["nodes_status"] = object{"up": 0, "down": 0}
+
+			s.event(linodego.ActionNodebalancerConfigCreate, nodeBalancerEntity(nodeBalancer), nil, linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(nodeBalancer, _ object) {
+			s.event(linodego.ActionNodebalancerConfigDelete, nodeBalancerEntity(nodeBalancer), nil, linodego.EventFinished)
+		},
+	})
+
+	s.register(mux, resource{
+		path: nodeBalancersPath + "/{nodeBalancerID}/configs/{configID}/nodes",
+		fields: func(int) object {
+			return object{
+				"address": "",
+				"label":   "",
+				"weight":  100,
+				"mode":    linodego.ModeAccept,
+			}
+		},
+		create: func(config, node, _ object) error {
+			if err := requireFields(node, "address", "label"); err != nil {
+				return err
+			}
+
+			node["status"] = "Unknown"
+			node["config_id"] = config["id"]
+			node["nodebalancer_id"] = config["nodebalancer_id"]
+
+			return nil
+		},
+	})
+}
+
+func nodeBalancerEntity(nodeBalancer object) object {
+	return entity(linodego.EntityNodebalancer, nodeBalancersPath, nodeBalancer, "label")
+}
+
+func (s *Server) registerVPCs(mux *http.ServeMux) {
+	subnets := resource{
+		path: vpcsPath + "/{vpcID}/subnets",
+		fields: func(int) object {
+			return object{
+				"label": "",
+				"ipv4":  "",
+			}
+		},
+		readOnly: []string{"ipv4"},
+		create: func(vpc, subnet, _ object) error {
+			if err := requireFields(subnet, "label", "ipv4"); err != nil {
+				return err
+			}
+
+			subnet["linodes"] = []any{}
+
+			// Subnets are included in VPCs, and updated along with them
+			vpc["subnets"] = append(vpc["subnets"].([]object), subnet)
+
+			return nil
+		},
+		deleted: func(vpc, subnet object) {
+			vpc["subnets"] = slices.DeleteFunc(vpc["subnets"].([]object), func(o object) bool {
+				return o["id"] == subnet["id"]
+			})
+		},
+	}
+
+	s.register(mux, resource{
+		path: vpcsPath,
+		fields: func(int) object {
+			return object{
+				"label":       "",
+				"description": "",
+				"region":      "",
+			}
+		},
+		readOnly: []string{"region"},
+		create: func(_, vpc, body object) error {
+			if err := requireFields(vpc, "label", "region"); err != nil {
+				return err
+			}
+
+			vpc["subnets"] = []object{}
+
+			bodySubnets, _ := body["subnets"].([]any)
+			for _, bodySubnet := range bodySubnets {
+				subnet, _ := bodySubnet.(map[string]any)
+
+				key := fmt.Sprintf("%s/%d/subnets", vpcsPath, vpc["id"])
+				if _, err := s.create(subnets, key, vpc, subnet); err != nil {
+					return err
+				}
+			}
+
+			s.event(linodego.ActionVPCCreate, vpcEntity(vpc), nil, linodego.EventFinished)
+
+			return nil
+		},
+		deleted: func(_, vpc object) {
+			s.event(linodego.ActionVPCDelete, vpcEntity(vpc), nil, linodego.EventFinished)
+		},
+	})
+
+	s.register(mux, subnets)
+}
+
+func vpcEntity(vpc object) object {
+	return entity(linodego.EntityVPC, vpcsPath, vpc, "label")
+}
+
+func (s *Server) registerTags(mux *http.ServeMux) {
+	s.handle(mux, http.MethodGet, "tags", func(r *http.Request) (any, error) {
+		labels := make(map[string]bool)
+		for label := range s.tags {
+			labels[label] = true
+		}
+
+		for _, t := range taggedTypes {
+			for _, obj := range s.collection(t.path).list() {
+				for _, label := range tags(obj) {
+					labels[label] = true
+				}
+			}
+		}
+
+		sorted := make([]string, 0, len(labels))
+		for label := range labels {
+			sorted = append(sorted, label)
+		}
+
+		sort.Strings(sorted)
+
+		result := make([]object, len(sorted))
+		for i, label := range sorted {
+			result[i] = object{"label": label}
+		}
+
+		return list(r, result)
+	})
+
+	s.handle(mux, http.MethodPost, "tags", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := requireFields(body, "label"); err != nil {
+			return nil, err
+		}
+
+		label, ok := body["label"].(string)
+		if !ok {
+			return nil, errBadRequest("label", "label must be a string")
+		}
+
+		for _, t := range taggedTypes {
+			ids, _ := body[t.field].([]any)
+			for _, id := range ids {
+				objID, _ := toInt(id)
+
+				obj := s.collection(t.path).get(objID)
+				if obj == nil {
+					return nil, errBadRequest(t.field, fmt.Sprintf("%s %v not found", t.name, id))
+				}
+
+				if !slices.Contains(tags(obj), label) {
+					obj["tags"] = append(tags(obj), label)
+				}
+			}
+		}
+
+		s.tags[label] = true
+
+		return object{"label": label}, nil
+	})
+
+	s.handle(mux, http.MethodGet, "tags/{label}", func(r *http.Request) (any, error) {
+		label := r.PathValue("label")
+
+		var result []object
+
+		for _, t := range taggedTypes {
+			for _, obj := range s.collection(t.path).list() {
+				if slices.Contains(tags(obj), label) {
+					result = append(result, object{"type": t.name, "data": obj})
+				}
+			}
+		}
+
+		return list(r, result)
+	})
+
+	s.handle(mux, http.MethodDelete, "tags/{label}", func(r *http.Request) (any, error) {
+		label := r.PathValue("label")
+
+		for _, t := range taggedTypes {
+			for _, obj := range s.collection(t.path).list() {
+				obj["tags"] = slices.DeleteFunc(tags(obj), func(tag string) bool { return tag == label })
+			}
+		}
+
+		delete(s.tags, label)
+
+		return object{}, nil
+	})
+}
+
+// tags returns the tags of the given object.
+func tags(obj object) []string {
+	var result []string
+
+	switch values := obj["tags"].(type) {
+	case []string:
+		result = append(result, values...)
+	case []any:
+		for _, value := range values {
+			if tag, ok := value.(string); ok {
+				result = append(result, tag)
+			}
+		}
+	}
+
+	return result
+}
+
+func (s *Server) registerEvents(mux *http.ServeMux) {
+	s.handle(mux, http.MethodGet, eventsPath, func(r *http.Request) (any, error) {
+		events := s.collection(eventsPath).list()
+
+		// Events are listed newest first
+		slices.Reverse(events)
+
+		return list(r, events)
+	})
+
+	s.handle(mux, http.MethodGet, eventsPath+"/{id}", func(r *http.Request) (any, error) {
+		_, _, event, err := s.lookup(eventsPath, r)
+		return event, err
+	})
+
+	for _, field := range []string{"seen", "read"} {
+		s.handle(mux, http.MethodPost, eventsPath+"/{id}/"+field, func(r *http.Request) (any, error) {
+			_, _, event, err := s.lookup(eventsPath, r)
+			if err != nil {
+				return nil, err
+			}
+
+			event[field] = true
+
+			return object{}, nil
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package linodegotest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*Server, *linodego.Client) {
+	t.Helper()
+
+	server := NewServer(&ServerOptions{TransitionDelay: 10 * time.Millisecond})
+	t.Cleanup(server.Close)
+
+	return server, server.Client()
+}
+
+func TestServer_Instances(t *testing.T) {
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Image:    "linode/debian12",
+		RootPass: "hunter2",
+		Tags:     []string{"web"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceProvisioning, instance.Status)
+	require.Equal(t, fmt.Sprintf("linode%d", instance.ID), instance.Label)
+	require.Len(t, instance.IPv4, 1)
+	require.NotNil(t, instance.Created)
+
+	instance, err = client.WaitForInstanceStatus(ctx, instance.ID, linodego.InstanceRunning, 5)
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceRunning, instance.Status)
+
+	disks, err := client.ListInstanceDisks(ctx, instance.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, disks, 2)
+	require.Equal(t, linodego.DiskReady, disks[0].Status)
+
+	configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	require.Equal(t, disks[0].ID, configs[0].Devices.SDA.DiskID)
+
+	poller, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
+	require.NoError(t, err)
+
+	require.NoError(t, client.ShutdownInstance(ctx, instance.ID))
+
+	event, err := poller.WaitForFinished(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, linodego.ActionLinodeShutdown, event.Action)
+	require.Equal(t, instance.ID, int(event.Entity.ID.(float64)))
+
+	instance, err = client.GetInstance(ctx, instance.ID)
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceOffline, instance.Status)
+
+	instance, err = client.UpdateInstance(ctx, instance.ID, linodego.InstanceUpdateOptions{Label: "renamed"})
+	require.NoError(t, err)
+	require.Equal(t, "renamed", instance.Label)
+
+	require.NoError(t, client.DeleteInstance(ctx, instance.ID))
+
+	_, err = client.GetInstance(ctx, instance.ID)
+	require.True(t, linodego.IsNotFound(err))
+
+	_, err = client.ListInstanceDisks(ctx, instance.ID, nil)
+	require.True(t, linodego.IsNotFound(err))
+
+	_, err = client.CreateInstance(ctx, linodego.InstanceCreateOptions{Type: "g6-nanode-1"})
+	require.ErrorContains(t, err, "region is required")
+}
+
+func TestServer_ListOptions(t *testing.T) {
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	for i := range 30 {
+		tag := "staging"
+		if i%3 == 0 {
+			tag = "prod"
+		}
+
+		_, err := client.CreateDomain(ctx, linodego.DomainCreateOptions{
+			Domain:   fmt.Sprintf("example-%02d.com", i),
+			Type:     linodego.DomainTypeMaster,
+			SOAEmail: "admin@example.com",
+			Tags:     []string{tag},
+		})
+		require.NoError(t, err)
+	}
+
+	page, err := client.ListDomains(ctx, &linodego.ListOptions{PageOptions: &linodego.PageOptions{Page: 2}, PageSize: 25})
+	require.NoError(t, err)
+	require.Len(t, page, 5)
+	require.Equal(t, "example-25.com", page[0].Domain)
+
+	domains, err := client.ListDomains(ctx, &linodego.ListOptions{PageSize: 25})
+	require.NoError(t, err)
+	require.Len(t, domains, 30)
+
+	filter := linodego.Filter{OrderBy: "domain", Order: linodego.Descending}
+	filter.AddField(linodego.Eq, "tags", "prod")
+	filter.AddField(linodego.Contains, "domain", "-1")
+
+	filterJSON, err := filter.MarshalJSON()
+	require.NoError(t, err)
+
+	domains, err = client.ListDomains(ctx, &linodego.ListOptions{Filter: string(filterJSON)})
+	require.NoError(t, err)
+	require.Len(t, domains, 3)
+	require.Equal(t, "example-18.com", domains[0].Domain)
+	require.Equal(t, "example-15.com", domains[1].Domain)
+
+	orFilter := linodego.Or("", "",
+		&linodego.Comp{Column: "domain", Operator: linodego.Eq, Value: "example-00.com"},
+		&linodego.Comp{Column: "domain", Operator: linodego.Gte, Value: "example-28.com"},
+	)
+
+	filterJSON, err = orFilter.MarshalJSON()
+	require.NoError(t, err)
+
+	domains, err = client.ListDomains(ctx, &linodego.ListOptions{Filter: string(filterJSON)})
+	require.NoError(t, err)
+	require.Len(t, domains, 3)
+
+	nestedFilter := linodego.Filter{}
+	nestedFilter.Add(
+		linodego.Or("", "",
+			linodego.DomainFilter.Domain().Eq("example-01.com"),
+			linodego.DomainFilter.Domain().Eq("example-03.com"),
+		),
+		linodego.DomainFilter.Tags().Eq("prod"),
+	)
+
+	filterJSON, err = nestedFilter.MarshalJSON()
+	require.NoError(t, err)
+
+	domains, err = client.ListDomains(ctx, &linodego.ListOptions{Filter: string(filterJSON)})
+	require.NoError(t, err)
+	require.Len(t, domains, 1)
+	require.Equal(t, "example-03.com", domains[0].Domain)
+
+	_, err = client.ListDomains(ctx, &linodego.ListOptions{Filter: `{"domain": {"+like": "example"}}`})
+	require.ErrorContains(t, err, "unknown operator +like")
+
+	_, err = client.ListDomains(ctx, &linodego.ListOptions{PageSize: 10})
+	require.ErrorContains(t, err, "Must be between 25 and 500")
+}
+
+func TestServer_SettleDistantTransitions(t *testing.T) {
+	// Transitions scheduled beyond 2038 should still be settled
+	server := NewServer(&ServerOptions{TransitionDelay: 20 * 365 * 24 * time.Hour})
+	t.Cleanup(server.Close)
+
+	client := server.Client()
+	ctx := context.Background()
+
+	volume, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{Label: "data", Region: "us-east"})
+	require.NoError(t, err)
+	require.Equal(t, linodego.VolumeCreating, volume.Status)
+
+	server.Settle()
+
+	volume, err = client.GetVolume(ctx, volume.ID)
+	require.NoError(t, err)
+	require.Equal(t, linodego.VolumeActive, volume.Status)
+}
+
+func TestServer_Volumes(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1"})
+	require.NoError(t, err)
+
+	volume, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{Label: "data", Region: "us-east"})
+	require.NoError(t, err)
+	require.Equal(t, linodego.VolumeCreating, volume.Status)
+
+	server.Settle()
+
+	volume, err = client.GetVolume(ctx, volume.ID)
+	require.NoError(t, err)
+	require.Equal(t, linodego.VolumeActive, volume.Status)
+
+	_, err = client.AttachVolume(ctx, volume.ID, &linodego.VolumeAttachOptions{LinodeID: instance.ID})
+	require.NoError(t, err)
+
+	volume, err = client.WaitForVolumeLinodeID(ctx, volume.ID, &instance.ID, 5)
+	require.NoError(t, err)
+	require.Equal(t, instance.ID, *volume.LinodeID)
+
+	require.NoError(t, client.DeleteInstance(ctx, instance.ID))
+
+	volume, err = client.GetVolume(ctx, volume.ID)
+	require.NoError(t, err)
+	require.Nil(t, volume.LinodeID)
+}
+
+func TestServer_Resources(t *testing.T) {
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Tags:   []string{"prod"},
+	})
+	require.NoError(t, err)
+
+	nodeBalancer, err := client.CreateNodeBalancer(ctx, linodego.NodeBalancerCreateOptions{Region: "us-east"})
+	require.NoError(t, err)
+
+	config, err := client.CreateNodeBalancerConfig(ctx, nodeBalancer.ID, linodego.NodeBalancerConfigCreateOptions{Port: 443})
+	require.NoError(t, err)
+	require.Equal(t, linodego.ProtocolHTTP, config.Protocol)
+
+	node, err := client.CreateNodeBalancerNode(ctx, nodeBalancer.ID, config.ID, linodego.NodeBalancerNodeCreateOptions{
+		Address: "192.168.1.1:80",
+		Label:   "web",
+	})
+	require.NoError(t, err)
+	require.Equal(t, config.ID, node.ConfigID)
+	require.Equal(t, nodeBalancer.ID, node.NodeBalancerID)
+
+	firewall, err := client.CreateFirewall(ctx, linodego.FirewallCreateOptions{
+		Label:   "web",
+		Devices: linodego.DevicesCreationOptions{Linodes: []int{instance.ID}},
+	})
+	require.NoError(t, err)
+
+	devices, err := client.ListFirewallDevices(ctx, firewall.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	require.Equal(t, instance.ID, devices[0].Entity.ID)
+
+	vpc, err := client.CreateVPC(ctx, linodego.VPCCreateOptions{
+		Label:   "vpc",
+		Region:  "us-east",
+		Subnets: []linodego.VPCSubnetCreateOptions{{Label: "subnet", IPv4: "10.0.0.0/24"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, vpc.Subnets, 1)
+
+	_, err = client.UpdateVPCSubnet(ctx, vpc.ID, vpc.Subnets[0].ID, linodego.VPCSubnetUpdateOptions{Label: "renamed"})
+	require.NoError(t, err)
+
+	vpc, err = client.GetVPC(ctx, vpc.ID)
+	require.NoError(t, err)
+	require.Equal(t, "renamed", vpc.Subnets[0].Label)
+
+	_, err = client.CreateTag(ctx, linodego.TagCreateOptions{Label: "lb", NodeBalancers: []int{nodeBalancer.ID}})
+	require.NoError(t, err)
+
+	tags, err := client.ListTags(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.Tag{{Label: "lb"}, {Label: "prod"}}, tags)
+
+	objects, err := client.ListTaggedObjects(ctx, "prod", nil)
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	require.Equal(t, instance.ID, objects[0].Data.(linodego.Instance).ID)
+
+	require.NoError(t, client.DeleteTag(ctx, "prod"))
+
+	instance, err = client.GetInstance(ctx, instance.ID)
+	require.NoError(t, err)
+	require.Empty(t, instance.Tags)
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	resp, err := http.Get(server.URL + "/v4/linode/instances")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -139,37 +139,18 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LKEVersion), nil
-	}
-
-	response, err := getPaginatedResults[LKEVersion](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, endpoint, &cacheExpiryTime, func(ctx context.Context) ([]LKEVersion, error) {
+		return getPaginatedResults[LKEVersion](ctx, c, e, opts)
+	})
 }
 
 // GetLKEVersion gets details about a specific LKE Version. This endpoint is cached by default.
 func (c *Client) GetLKEVersion(ctx context.Context, version string) (*LKEVersion, error) {
 	e := formatAPIPath("lke/versions/%s", version)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LKEVersion)
-		return &result, nil
-	}
-
-	response, err := doGETRequest[LKEVersion](ctx, c, e)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(e, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, e, &cacheExpiryTime, func(ctx context.Context) (*LKEVersion, error) {
+		return doGETRequest[LKEVersion](ctx, c, e)
+	})
 }
 
 // ListLKEClusterAPIEndpoints gets the API Endpoint for the LKE Cluster specified
@@ -201,6 +182,8 @@ func (c *Client) GetLKECluster(ctx context.Context, clusterID int) (*LKECluster,
 
 // CreateLKECluster creates a LKECluster
 func (c *Client) CreateLKECluster(ctx context.Context, opts LKEClusterCreateOptions) (*LKECluster, error) {
+	c.applyProfileDefaults(&opts)
+
 	e := "lke/clusters"
 	response, err := doPOSTRequest[LKECluster](ctx, c, e, opts)
 	if err != nil {
@@ -32,16 +32,7 @@ func (c *Client) ListLKETypes(ctx context.Context, opts *ListOptions) ([]LKEType
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LKEType), nil
-	}
-
-	response, err := getPaginatedResults[LKEType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, endpoint, &cacheExpiryTime, func(ctx context.Context) ([]LKEType, error) {
+		return getPaginatedResults[LKEType](ctx, c, e, opts)
+	})
 }
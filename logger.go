@@ -1,47 +1,48 @@
 package linodego
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"log/slog"
+	"net/url"
 	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
 )
 
-//nolint:unused
 type httpLogger interface {
 	Errorf(format string, v ...interface{})
 	Warnf(format string, v ...interface{})
 	Debugf(format string, v ...interface{})
 }
 
-//nolint:unused
 type logger struct {
 	l *log.Logger
 }
 
-//nolint:unused
 func createLogger() *logger {
 	l := &logger{l: log.New(os.Stderr, "", log.Ldate|log.Lmicroseconds)}
 	return l
 }
 
-//nolint:unused
 var _ httpLogger = (*logger)(nil)
 
-//nolint:unused
 func (l *logger) Errorf(format string, v ...interface{}) {
 	l.output("ERROR RESTY "+format, v...)
 }
 
-//nolint:unused
 func (l *logger) Warnf(format string, v ...interface{}) {
 	l.output("WARN RESTY "+format, v...)
 }
 
-//nolint:unused
 func (l *logger) Debugf(format string, v ...interface{}) {
 	l.output("DEBUG RESTY "+format, v...)
 }
 
-//nolint:unused
 func (l *logger) output(format string, v ...interface{}) { //nolint:goprintffuncname
 	if len(v) == 0 {
 		l.l.Print(format)
@@ -49,3 +50,145 @@ func (l *logger) output(format string, v ...interface{}) { //nolint:goprintffunc
 	}
 	l.l.Printf(format, v...)
 }
+
+// clientLogState holds the structured logger shared between copies of a Client.
+type clientLogState struct {
+	logger atomic.Pointer[slog.Logger]
+
+	// Whether the logger was set using SetSlogLogger
+	custom atomic.Bool
+}
+
+func newClientLogState() *clientLogState {
+	state := &clientLogState{}
+
+	if envDebug {
+		state.logger.Store(envLogger)
+	} else {
+		state.logger.Store(slog.New(discardHandler{}))
+	}
+
+	return state
+}
+
+func (s *clientLogState) get() *slog.Logger {
+	return s.logger.Load()
+}
+
+// SetSlogHandler sets the handler for structured log messages emitted by this client.
+// By default, no log messages are emitted unless the LINODE_DEBUG environment variable is set.
+func (c *Client) SetSlogHandler(handler slog.Handler) *Client {
+	if handler == nil {
+		handler = discardHandler{}
+	}
+
+	return c.SetSlogLogger(slog.New(handler))
+}
+
+// SetSlogLogger sets the logger for structured log messages emitted by this client.
+// Debug output (see SetDebug) will also be written to this logger unless
+// a Logger is set using SetLogger.
+func (c *Client) SetSlogLogger(logger *slog.Logger) *Client {
+	if logger == nil {
+		logger = slog.New(discardHandler{})
+	}
+
+	c.logState.logger.Store(logger)
+	c.logState.custom.Store(true)
+
+	return c
+}
+
+// clientLogger is the default Logger for debug output and errors reported by resty.
+//
+// Errors and warnings are written to the client's structured logger so nothing
+// is written to stderr by default. Debug output is only produced if explicitly
+// enabled using SetDebug, and is written to stderr unless a structured
+// logger has been configured.
+type clientLogger struct {
+	state    *clientLogState
+	fallback httpLogger
+}
+
+var _ Logger = (*clientLogger)(nil)
+
+func newClientLogger(state *clientLogState) *clientLogger {
+	return &clientLogger{state: state, fallback: createLogger()}
+}
+
+func (l *clientLogger) Errorf(format string, v ...interface{}) {
+	l.state.get().Error(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
+
+func (l *clientLogger) Warnf(format string, v ...interface{}) {
+	l.state.get().Warn(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
+
+func (l *clientLogger) Debugf(format string, v ...interface{}) {
+	if !l.state.custom.Load() {
+		l.fallback.Debugf(format, v...)
+		return
+	}
+
+	l.state.get().Debug(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
+
+// discardHandler is an slog.Handler that discards all log messages.
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (d discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return d }
+func (d discardHandler) WithGroup(string) slog.Handler           { return d }
+
+// requestPath returns the path of the given request URL for use in log messages.
+func requestPath(requestURL string) string {
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return requestURL
+	}
+
+	return u.Path
+}
+
+func logResponseCompleted(logger *slog.Logger, method, path string, status, attempt int, duration time.Duration) {
+	logger.Debug(
+		"Request completed",
+		"method", method,
+		"path", path,
+		"status", status,
+		"attempt", attempt,
+		"duration", duration,
+	)
+}
+
+func logRequestFailed(logger *slog.Logger, method, path string, err error) {
+	logger.Debug(
+		"Request failed",
+		"method", method,
+		"path", path,
+		"error", err,
+	)
+}
+
+// configureLogging registers the hooks used to log the lifecycle
+// of requests made through resty.
+func (c *Client) configureLogging() {
+	state := c.logState
+
+	c.resty.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+		logResponseCompleted(
+			state.get(),
+			resp.Request.Method,
+			requestPath(resp.Request.URL),
+			resp.StatusCode(),
+			resp.Request.Attempt,
+			resp.Time(),
+		)
+		return nil
+	})
+
+	c.resty.OnError(func(req *resty.Request, err error) {
+		logRequestFailed(state.get(), req.Method, requestPath(req.URL), err)
+	})
+}
@@ -0,0 +1,105 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLogRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var result []map[string]any
+
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var record map[string]any
+		require.NoError(t, decoder.Decode(&record))
+		result = append(result, record)
+	}
+
+	return result
+}
+
+func findLogRecord(records []map[string]any, msg string) map[string]any {
+	for _, record := range records {
+		if record["msg"] == msg {
+			return record
+		}
+	}
+
+	return nil
+}
+
+func TestClient_SlogHandler(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		client.SetRetryWaitTime(0)
+
+		var buf bytes.Buffer
+		client.SetSlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		httpmock.RegisterResponder("GET", "=~^.*/linode/instances/123$",
+			httpmock.NewJsonResponderOrPanic(429, APIError{}).Then(
+				httpmock.NewJsonResponderOrPanic(200, Instance{ID: 123}),
+			),
+		)
+
+		_, err := client.GetInstance(context.Background(), 123)
+		require.NoError(t, err)
+
+		records := decodeLogRecords(t, &buf)
+
+		retry := findLogRecord(records, "Retrying request")
+		require.NotNil(t, retry)
+		require.Equal(t, "INFO", retry["level"])
+		require.Equal(t, http.MethodGet, retry["method"])
+		require.Equal(t, "/v4/linode/instances/123", retry["path"])
+		require.EqualValues(t, http.StatusTooManyRequests, retry["status"])
+		require.EqualValues(t, 1, retry["attempt"])
+		require.NotEmpty(t, retry["reason"])
+
+		completed := findLogRecord(records, "Request completed")
+		require.NotNil(t, completed)
+		require.Equal(t, "DEBUG", completed["level"])
+		require.Contains(t, completed, "duration")
+	})
+}
+
+func TestClient_SilentByDefault(t *testing.T) {
+	stderr := os.Stderr
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	os.Stderr = w
+	defer func() {
+		os.Stderr = stderr
+	}()
+
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetRetryWaitTime(0)
+
+	httpmock.RegisterResponder("GET", "=~^.*/linode/instances/123$",
+		httpmock.NewJsonResponderOrPanic(503, APIError{}).Then(
+			httpmock.NewJsonResponderOrPanic(404, APIError{Errors: []APIErrorReason{{Reason: "Not found"}}}),
+		),
+	)
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.Error(t, err)
+
+	require.NoError(t, w.Close())
+
+	var output bytes.Buffer
+	_, err = output.ReadFrom(r)
+	require.NoError(t, err)
+	require.Empty(t, output.String())
+}
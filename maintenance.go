@@ -0,0 +1,231 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// APIDefaultMaintenancePause is the default duration requests are paused for
+// during maintenance if the API does not return a Retry-After header.
+const APIDefaultMaintenancePause = time.Minute
+
+// MaintenanceError is returned when a request is rejected because the
+// Linode API is under maintenance. It can be retrieved from errors
+// returned by the client using errors.As.
+type MaintenanceError struct {
+	// Mode is the value of the X-Maintenance-Mode response header.
+	Mode string
+
+	// RetryAfter is the delay requested by the Retry-After response header, if any.
+	RetryAfter time.Duration
+}
+
+func (e *MaintenanceError) Error() string {
+	return fmt.Sprintf(
+		"Linode API is under maintenance (%s) - please see status.linode.com for more information",
+		e.Mode,
+	)
+}
+
+// newMaintenanceError returns a MaintenanceError for the given response headers,
+// or nil if the response does not indicate that the API is under maintenance.
+func newMaintenanceError(status int, header http.Header) *MaintenanceError {
+	if !isMaintenanceResponse(status, header) {
+		return nil
+	}
+
+	result := &MaintenanceError{
+		Mode: header.Get(maintenanceModeHeaderName),
+	}
+
+	if retryAfter, err := strconv.Atoi(header.Get(retryAfterHeaderName)); err == nil && retryAfter > 0 {
+		result.RetryAfter = time.Duration(retryAfter) * time.Second
+	}
+
+	return result
+}
+
+// coupleMaintenanceError returns the Error for the given maintenance response.
+func coupleMaintenanceError(resp *http.Response, maintenanceError *MaintenanceError) *Error {
+	return &Error{
+		Code:     http.StatusServiceUnavailable,
+		Message:  maintenanceError.Error(),
+		Response: resp,
+		Err:      maintenanceError,
+	}
+}
+
+// MaintenanceStatus describes a change in the maintenance status of the Linode API.
+type MaintenanceStatus struct {
+	// Active is whether the API is under maintenance.
+	Active bool
+
+	// Until is the time requests are paused until while maintenance is active.
+	Until time.Time
+
+	// Error is the error returned by the API when maintenance was detected.
+	Error *MaintenanceError
+}
+
+// MaintenancePauseOptions configures how a client pauses requests
+// while the Linode API is under maintenance.
+type MaintenancePauseOptions struct {
+	// Pause is how long requests are paused for when the API does not
+	// return a Retry-After header. Defaults to APIDefaultMaintenancePause.
+	Pause time.Duration
+
+	// OnStatusChange is called when maintenance is first detected and when it ends,
+	// allowing callers to defer work rather than waiting on paused requests.
+	OnStatusChange func(MaintenanceStatus)
+}
+
+// maintenanceState tracks API maintenance windows and is shared between copies of a Client.
+type maintenanceState struct {
+	mu sync.Mutex
+
+	opts   *MaintenancePauseOptions
+	active bool
+	until  time.Time
+}
+
+// SetMaintenancePause configures the client to pause all outgoing requests while the
+// Linode API is under maintenance, retrying requests rejected due to maintenance once
+// the maintenance window ends. Passing nil disables pausing, in which case requests
+// rejected due to maintenance fail with a *MaintenanceError.
+func (c *Client) SetMaintenancePause(opts *MaintenancePauseOptions) *Client {
+	var copied *MaintenancePauseOptions
+	if opts != nil {
+		copied = new(MaintenancePauseOptions)
+		*copied = *opts
+
+		if copied.Pause <= 0 {
+			copied.Pause = APIDefaultMaintenancePause
+		}
+	}
+
+	c.maintenance.mu.Lock()
+	defer c.maintenance.mu.Unlock()
+
+	c.maintenance.opts = copied
+	c.maintenance.active = false
+	c.maintenance.until = time.Time{}
+
+	return c
+}
+
+// pausing returns whether requests rejected due to maintenance should be retried.
+func (s *maintenanceState) pausing() bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.opts != nil
+}
+
+// retryError returns the MaintenanceError for the given response if the request
+// should be retried once maintenance ends, or nil otherwise.
+func (s *maintenanceState) retryError(status int, header http.Header) *MaintenanceError {
+	if s == nil || !s.pausing() {
+		return nil
+	}
+
+	return newMaintenanceError(status, header)
+}
+
+// wait blocks until the current maintenance window ends or the context is cancelled.
+func (s *maintenanceState) wait(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		delay := time.Until(s.until)
+		s.mu.Unlock()
+
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// observe updates the maintenance window using the given response.
+func (s *maintenanceState) observe(status int, header http.Header) {
+	maintenanceError := newMaintenanceError(status, header)
+
+	s.mu.Lock()
+
+	if s.opts == nil {
+		s.mu.Unlock()
+		return
+	}
+
+	var (
+		callback = s.opts.OnStatusChange
+		changed  bool
+		result   MaintenanceStatus
+	)
+
+	switch {
+	case maintenanceError != nil:
+		pause := s.opts.Pause
+		if maintenanceError.RetryAfter > 0 {
+			pause = maintenanceError.RetryAfter
+		}
+
+		changed = !s.active
+		s.active = true
+		s.until = time.Now().Add(pause)
+
+		result = MaintenanceStatus{Active: true, Until: s.until, Error: maintenanceError}
+	case s.active:
+		changed = true
+		s.active = false
+		s.until = time.Time{}
+	}
+
+	s.mu.Unlock()
+
+	// The callback is called without holding the lock so it may use the client
+	if changed && callback != nil {
+		callback(result)
+	}
+}
+
+// configureMaintenance registers the hooks used to pause requests made through
+// both resty and the net/http execution path during maintenance.
+func (c *Client) configureMaintenance() {
+	state := c.maintenance
+
+	c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		return state.wait(req.Context())
+	})
+
+	c.resty.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+		state.observe(resp.StatusCode(), resp.Header())
+		return nil
+	})
+
+	c.http.httpOnBeforeRequest(func(req *http.Request) error {
+		return state.wait(req.Context())
+	})
+
+	c.http.httpOnAfterResponse(func(resp *http.Response) error {
+		state.observe(resp.StatusCode, resp.Header)
+		return nil
+	})
+}
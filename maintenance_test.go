@@ -0,0 +1,97 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func maintenanceResponder(retryAfter string) httpmock.Responder {
+	return func(_ *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(http.StatusServiceUnavailable, "")
+		resp.Header.Set(maintenanceModeHeaderName, "Currently in maintenance mode.")
+		resp.Header.Set("Content-Type", "application/json")
+
+		if retryAfter != "" {
+			resp.Header.Set(retryAfterHeaderName, retryAfter)
+		}
+
+		return resp, nil
+	}
+}
+
+func TestClient_MaintenanceError(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		client.SetRetryWaitTime(0)
+
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/123"),
+			maintenanceResponder("30"))
+
+		_, err := client.GetInstance(context.Background(), 123)
+		require.Error(t, err)
+		require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+		var maintenanceErr *MaintenanceError
+		require.True(t, errors.As(err, &maintenanceErr))
+		require.Equal(t, "Currently in maintenance mode.", maintenanceErr.Mode)
+		require.Equal(t, 30*time.Second, maintenanceErr.RetryAfter)
+		require.True(t, ErrHasStatus(err, http.StatusServiceUnavailable))
+	})
+}
+
+func TestClient_MaintenancePause(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		client.SetRetryWaitTime(0)
+
+		var (
+			mu       sync.Mutex
+			statuses []MaintenanceStatus
+		)
+
+		client.SetMaintenancePause(&MaintenancePauseOptions{
+			Pause: 10 * time.Millisecond,
+			OnStatusChange: func(status MaintenanceStatus) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				statuses = append(statuses, status)
+			},
+		})
+
+		// Non-idempotent requests should also be retried since they were rejected
+		httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/linode/instances"),
+			maintenanceResponder("").
+				Then(maintenanceResponder("")).
+				Then(httpmock.NewJsonResponderOrPanic(200, Instance{ID: 123})))
+
+		instance, err := client.CreateInstance(context.Background(), InstanceCreateOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 123, instance.ID)
+		require.Equal(t, 3, httpmock.GetTotalCallCount())
+
+		mu.Lock()
+		require.Len(t, statuses, 2)
+		require.True(t, statuses[0].Active)
+		require.NotNil(t, statuses[0].Error)
+		require.False(t, statuses[1].Active)
+		mu.Unlock()
+
+		// Paused requests should respect context cancellation
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/123"),
+			maintenanceResponder("60"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		_, err = client.GetInstance(ctx, 123)
+		cancel()
+
+		require.Error(t, err)
+		require.Equal(t, 4, httpmock.GetTotalCallCount())
+	})
+}
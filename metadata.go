@@ -0,0 +1,169 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	requestIDHeaderName = "X-Request-Id"
+
+	// CorrelationIDHeaderName is the request header used to send
+	// correlation IDs set using ContextWithCorrelationID.
+	CorrelationIDHeaderName = "X-Correlation-Id"
+)
+
+// ResponseMetadata describes the response to an API request.
+type ResponseMetadata struct {
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Endpoint is the API endpoint of the request, e.g. "linode/instances/123".
+	Endpoint string
+
+	// StatusCode is the HTTP status code of the final response.
+	StatusCode int
+
+	// RequestID is the value of the X-Request-Id response header, if any.
+	RequestID string
+
+	// Attempts is the number of attempts made for the request, including retries.
+	Attempts int
+
+	// Duration is the time taken by the request, including retries.
+	Duration time.Duration
+
+	// RateLimit is the rate limit status reported by the final response, if any.
+	RateLimit *RateLimitStatus
+
+	// Header contains the headers of the final response.
+	Header http.Header
+}
+
+// RateLimitStatus is the rate limit status reported by the X-RateLimit-* response headers.
+type RateLimitStatus struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// parseRateLimitStatus returns the rate limit status reported by the given
+// response headers, or nil if the headers do not report one.
+func parseRateLimitStatus(header http.Header) *RateLimitStatus {
+	remaining, err := strconv.Atoi(header.Get(rateLimitRemainingHeaderName))
+	if err != nil {
+		return nil
+	}
+
+	limit, _ := strconv.Atoi(header.Get(rateLimitLimitHeaderName))
+
+	result := &RateLimitStatus{
+		Limit:     limit,
+		Remaining: remaining,
+	}
+
+	if resetUnix, err := strconv.ParseInt(header.Get(rateLimitResetHeaderName), 10, 64); err == nil {
+		result.Reset = time.Unix(resetUnix, 0)
+	}
+
+	return result
+}
+
+// record updates the metadata using a response to the given attempt of the request.
+func (m *ResponseMetadata) record(resp *http.Response, attempt int) {
+	if m == nil || resp == nil {
+		return
+	}
+
+	m.StatusCode = resp.StatusCode
+	m.RequestID = resp.Header.Get(requestIDHeaderName)
+	m.Attempts = attempt
+	m.RateLimit = parseRateLimitStatus(resp.Header)
+	m.Header = resp.Header.Clone()
+}
+
+// recordResty updates the metadata using the final resty response of the request.
+func (m *ResponseMetadata) recordResty(resp *resty.Response) {
+	if resp == nil || resp.RawResponse == nil || resp.Request == nil {
+		return
+	}
+
+	m.record(resp.RawResponse, resp.Request.Attempt)
+}
+
+type (
+	responseMetadataKey struct{}
+	requestMetadataKey  struct{}
+	correlationIDKey    struct{}
+)
+
+// ContextWithResponseMetadata returns a copy of the given context that causes the metadata
+// of responses to requests made using it to be stored in the given ResponseMetadata.
+// The ResponseMetadata is overwritten by each request, so it should not be shared
+// between concurrent requests.
+func ContextWithResponseMetadata(ctx context.Context, metadata *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, metadata)
+}
+
+func responseMetadataFromContext(ctx context.Context) *ResponseMetadata {
+	metadata, _ := ctx.Value(responseMetadataKey{}).(*ResponseMetadata)
+	return metadata
+}
+
+// requestMetadataFromContext returns the metadata being recorded for the
+// request made using the given context, if any.
+func requestMetadataFromContext(ctx context.Context) *ResponseMetadata {
+	metadata, _ := ctx.Value(requestMetadataKey{}).(*ResponseMetadata)
+	return metadata
+}
+
+// ContextWithCorrelationID returns a copy of the given context that causes requests made
+// using it to include the given correlation ID in the X-Correlation-Id request header.
+func ContextWithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, correlationID)
+}
+
+func correlationIDFromContext(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationIDKey{}).(string)
+	return correlationID
+}
+
+// withErrorMetadata attaches a copy of the given metadata to err if it
+// is an Error caused by a response from the API, and to its APIError, if any.
+func withErrorMetadata(err error, metadata *ResponseMetadata) error {
+	if metadata.StatusCode == 0 {
+		return err
+	}
+
+	copied := *metadata
+
+	switch e := err.(type) {
+	case Error:
+		e.setMetadata(&copied)
+		return e
+	case *Error:
+		e.setMetadata(&copied)
+		return e
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		e.setMetadata(&copied)
+	}
+
+	return err
+}
+
+// setMetadata sets the metadata of the error and of its APIError, if any.
+func (err *Error) setMetadata(metadata *ResponseMetadata) {
+	err.Metadata = metadata
+
+	if apiError := err.apiError(); apiError != nil {
+		apiError.Metadata = metadata
+	}
+}
@@ -0,0 +1,84 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimitStatus(t *testing.T) {
+	require.Nil(t, parseRateLimitStatus(http.Header{}))
+
+	header := http.Header{}
+	header.Set(rateLimitLimitHeaderName, "800")
+	header.Set(rateLimitRemainingHeaderName, "799")
+	header.Set(rateLimitResetHeaderName, "1700000000")
+
+	status := parseRateLimitStatus(header)
+	require.NotNil(t, status)
+	require.Equal(t, 800, status.Limit)
+	require.Equal(t, 799, status.Remaining)
+	require.Equal(t, int64(1700000000), status.Reset.Unix())
+}
+
+func TestClient_ResponseMetadata(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		client.SetRetryWaitTime(0)
+
+		respond := func(status int, body any) httpmock.Responder {
+			return func(req *http.Request) (*http.Response, error) {
+				require.Equal(t, "correlation-id", req.Header.Get(CorrelationIDHeaderName))
+
+				resp, err := httpmock.NewJsonResponse(status, body)
+				resp.Header.Set(requestIDHeaderName, "request-id")
+				resp.Header.Set(rateLimitRemainingHeaderName, "10")
+
+				return resp, err
+			}
+		}
+
+		tooManyRequests := respond(http.StatusTooManyRequests, APIError{Errors: []APIErrorReason{{Reason: "Too Many Requests"}}})
+
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/123"),
+			tooManyRequests.Then(respond(http.StatusOK, Instance{ID: 123})))
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/456"),
+			respond(http.StatusNotFound, APIError{Errors: []APIErrorReason{{Reason: "Not found"}}}))
+
+		var metadata ResponseMetadata
+
+		ctx := ContextWithCorrelationID(context.Background(), "correlation-id")
+		ctx = ContextWithResponseMetadata(ctx, &metadata)
+
+		_, err := client.GetInstance(ctx, 123)
+		require.NoError(t, err)
+
+		require.Equal(t, http.MethodGet, metadata.Method)
+		require.Equal(t, "linode/instances/123", metadata.Endpoint)
+		require.Equal(t, http.StatusOK, metadata.StatusCode)
+		require.Equal(t, "request-id", metadata.RequestID)
+		require.Equal(t, 2, metadata.Attempts)
+		require.Equal(t, 10, metadata.RateLimit.Remaining)
+		require.NotZero(t, metadata.Duration)
+
+		_, err = client.GetInstance(ctx, 456)
+		require.Error(t, err)
+
+		var e *Error
+		require.True(t, errors.As(err, &e))
+		require.NotNil(t, e.Metadata)
+		require.Equal(t, "linode/instances/456", e.Metadata.Endpoint)
+		require.Equal(t, http.StatusNotFound, e.Metadata.StatusCode)
+		require.Equal(t, "request-id", e.Metadata.RequestID)
+		require.Equal(t, 1, e.Metadata.Attempts)
+		require.Equal(t, *e.Metadata, metadata)
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		require.Same(t, e.Metadata, apiErr.Metadata)
+	})
+}
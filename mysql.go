@@ -154,6 +154,8 @@ func (c *Client) GetMySQLDatabase(ctx context.Context, databaseID int) (*MySQLDa
 
 // CreateMySQLDatabase creates a new MySQL Database using the createOpts as configuration, returns the new MySQL Database
 func (c *Client) CreateMySQLDatabase(ctx context.Context, opts MySQLCreateOptions) (*MySQLDatabase, error) {
+	c.applyProfileDefaults(&opts)
+
 	e := "databases/mysql/instances"
 	response, err := doPOSTRequest[MySQLDatabase](ctx, c, e, opts)
 	if err != nil {
@@ -30,16 +30,7 @@ func (c *Client) ListNetworkTransferPrices(ctx context.Context, opts *ListOption
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]NetworkTransferPrice), nil
-	}
-
-	response, err := getPaginatedResults[NetworkTransferPrice](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, endpoint, &cacheExpiryTime, func(ctx context.Context) ([]NetworkTransferPrice, error) {
+		return getPaginatedResults[NetworkTransferPrice](ctx, c, e, opts)
+	})
 }
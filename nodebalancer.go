@@ -125,6 +125,8 @@ func (c *Client) GetNodeBalancer(ctx context.Context, nodebalancerID int) (*Node
 
 // CreateNodeBalancer creates a NodeBalancer
 func (c *Client) CreateNodeBalancer(ctx context.Context, opts NodeBalancerCreateOptions) (*NodeBalancer, error) {
+	c.applyProfileDefaults(&opts)
+
 	e := "nodebalancers"
 	response, err := doPOSTRequest[NodeBalancer](ctx, c, e, opts)
 	if err != nil {
@@ -30,16 +30,7 @@ func (c *Client) ListNodeBalancerTypes(ctx context.Context, opts *ListOptions) (
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]NodeBalancerType), nil
-	}
-
-	response, err := getPaginatedResults[NodeBalancerType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, endpoint, &cacheExpiryTime, func(ctx context.Context) ([]NodeBalancerType, error) {
+		return getPaginatedResults[NodeBalancerType](ctx, c, e, opts)
+	})
 }
@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strconv"
 
@@ -33,6 +34,11 @@ type ListOptions struct {
 	// calls. QueryParams should be an instance of a struct containing fields with
 	// the `query` tag.
 	QueryParams any
+
+	// Concurrency is the maximum number of pages requested in parallel once the first
+	// page has been received, when all pages are requested. Defaults to the page
+	// concurrency of the client, see Client.SetPageConcurrency.
+	Concurrency int `json:"-"`
 }
 
 // NewListOptions simplified construction of ListOptions using only
@@ -85,6 +91,42 @@ func applyListOptionsToRequest(opts *ListOptions, req *resty.Request) error {
 	return nil
 }
 
+// applyListOptionsToHTTPRequest is the net/http equivalent of applyListOptionsToRequest.
+func applyListOptionsToHTTPRequest(opts *ListOptions, req *http.Request) error {
+	if opts == nil {
+		return nil
+	}
+
+	query := req.URL.Query()
+
+	if opts.QueryParams != nil {
+		params, err := flattenQueryStruct(opts.QueryParams)
+		if err != nil {
+			return fmt.Errorf("failed to apply list options: %w", err)
+		}
+
+		for k, v := range params {
+			query.Set(k, v)
+		}
+	}
+
+	if opts.PageOptions != nil && opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+
+	if opts.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+
+	req.URL.RawQuery = query.Encode()
+
+	if len(opts.Filter) > 0 {
+		req.Header.Set("X-Filter", opts.Filter)
+	}
+
+	return nil
+}
+
 type PagedResponse interface {
 	endpoint(...any) string
 	castResult(*resty.Request, string) (int, int, error)
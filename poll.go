@@ -0,0 +1,313 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Clock provides the current time and delays to Poll, allowing tests to control time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Sleep waits for the given duration, or returns the error of the
+	// given context if it is done first.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// systemClock is the Clock using the system time.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// PollOptions configure Poll.
+type PollOptions[T any] struct {
+	// Interval is the delay before the first fetch and between fetches.
+	// Defaults to APISecondsPerPoll seconds.
+	Interval time.Duration
+
+	// Multiplier is the factor the delay is multiplied by after each fetch,
+	// backing off exponentially. Values of 1 or less keep the delay constant.
+	Multiplier float64
+
+	// MaxInterval limits the delay between fetches when backing off, if set.
+	MaxInterval time.Duration
+
+	// Jitter randomizes each delay by up to the given fraction of it, between 0 and 1,
+	// to spread the requests of clients polling at the same time.
+	Jitter float64
+
+	// Immediate causes the state to be fetched before waiting for the first delay.
+	Immediate bool
+
+	// Timeout limits the time spent polling, if set. As with context.WithTimeout,
+	// a negative timeout has already elapsed, so the state is never fetched.
+	Timeout time.Duration
+
+	// Failure returns an error if the given state can no longer meet the condition,
+	// e.g. a failed event, stopping polling with the state and the error.
+	Failure func(state T) error
+
+	// Retry returns whether to keep polling after fetching the state failed with
+	// the given error. By default, polling stops with the error.
+	Retry func(err error) bool
+
+	// Progress is called after each fetch, e.g. to report progress to users.
+	Progress func(progress PollProgress[T])
+
+	// Clock provides the current time and delays. Defaults to the system clock.
+	Clock Clock
+}
+
+// PollProgress describes a fetch made by Poll.
+type PollProgress[T any] struct {
+	// Attempt is the number of the fetch, starting at 1.
+	Attempt int
+
+	// Elapsed is the time since polling started.
+	Elapsed time.Duration
+
+	// State is the state returned by the fetch.
+	State T
+
+	// Err is the error returned by the fetch, if it failed.
+	Err error
+}
+
+// PollTimeoutError is returned by Poll when the timeout or the deadline of its context
+// elapses before the condition is met. It wraps context.DeadlineExceeded.
+type PollTimeoutError[T any] struct {
+	// Attempts is the number of times the state was fetched.
+	Attempts int
+
+	// Elapsed is the time spent polling.
+	Elapsed time.Duration
+
+	// LastState is the last state fetched successfully, if any.
+	LastState T
+
+	// LastErr is the error of the last fetch, if it failed and was retried.
+	LastErr error
+}
+
+func (e *PollTimeoutError[T]) Error() string {
+	msg := fmt.Sprintf("%s after %d polls in %s", context.DeadlineExceeded, e.Attempts, e.Elapsed.Round(time.Millisecond))
+
+	if e.LastErr != nil {
+		msg += fmt.Sprintf(" (last error: %s)", e.LastErr)
+	}
+
+	return msg
+}
+
+func (e *PollTimeoutError[T]) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// errPollDeadline is returned by pollState.sleep when the timeout would elapse during the delay.
+var errPollDeadline = errors.New("poll deadline reached")
+
+// Poll fetches a state until it meets the given condition, returning the state.
+//
+// Polling stops with an error if the context is cancelled, if fetching the state fails
+// and the error should not be retried, or if the state can no longer meet the condition.
+// If the timeout or the deadline of the context elapses first, a *PollTimeoutError[T]
+// is returned which includes the last state fetched.
+func Poll[T any](
+	ctx context.Context,
+	fetch func(ctx context.Context) (T, error),
+	condition func(state T) bool,
+	opts *PollOptions[T],
+) (T, error) {
+	var zero T
+
+	p := pollState[T]{}
+	if opts != nil {
+		p.opts = *opts
+	}
+
+	if p.opts.Interval <= 0 {
+		p.opts.Interval = APISecondsPerPoll * time.Second
+	}
+
+	if p.opts.Clock == nil {
+		p.opts.Clock = systemClock{}
+	}
+
+	p.start = p.opts.Clock.Now()
+
+	if p.opts.Timeout != 0 {
+		p.deadline = p.start.Add(p.opts.Timeout)
+
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, p.opts.Timeout)
+		defer cancel()
+	}
+
+	delay := p.opts.Interval
+
+	for {
+		if p.attempts > 0 || !p.opts.Immediate {
+			if err := p.sleep(ctx, delay); err != nil {
+				return zero, p.stopped(ctx, err)
+			}
+
+			delay = p.nextDelay(delay)
+		}
+
+		if err := ctx.Err(); err != nil {
+			return zero, p.stopped(ctx, err)
+		}
+
+		p.attempts++
+
+		state, err := fetch(ctx)
+
+		if p.opts.Progress != nil {
+			p.opts.Progress(PollProgress[T]{
+				Attempt: p.attempts,
+				Elapsed: p.opts.Clock.Now().Sub(p.start),
+				State:   state,
+				Err:     err,
+			})
+		}
+
+		if err != nil {
+			// Requests failing as the context is done are reported as such
+			if ctx.Err() != nil {
+				return zero, p.stopped(ctx, ctx.Err())
+			}
+
+			if p.opts.Retry == nil || !p.opts.Retry(err) {
+				return state, err
+			}
+
+			p.lastErr = err
+
+			continue
+		}
+
+		p.last = state
+		p.lastErr = nil
+
+		if condition(state) {
+			return state, nil
+		}
+
+		if p.opts.Failure != nil {
+			if err := p.opts.Failure(state); err != nil {
+				return state, err
+			}
+		}
+	}
+}
+
+// pollState is the state of a call to Poll.
+type pollState[T any] struct {
+	opts     PollOptions[T]
+	start    time.Time
+	deadline time.Time
+	attempts int
+	last     T
+	lastErr  error
+}
+
+// sleep waits for the given delay with jitter applied, or until the deadline.
+func (p *pollState[T]) sleep(ctx context.Context, delay time.Duration) error {
+	if p.opts.Jitter > 0 {
+		delay += time.Duration(float64(delay) * p.opts.Jitter * (2*rand.Float64() - 1)) //nolint:gosec
+	}
+
+	if !p.deadline.IsZero() {
+		if remaining := p.deadline.Sub(p.opts.Clock.Now()); remaining < delay {
+			if err := p.opts.Clock.Sleep(ctx, max(remaining, 0)); err != nil {
+				return err
+			}
+
+			return errPollDeadline
+		}
+	}
+
+	return p.opts.Clock.Sleep(ctx, delay)
+}
+
+// nextDelay returns the delay following the given delay.
+func (p *pollState[T]) nextDelay(delay time.Duration) time.Duration {
+	if p.opts.Multiplier <= 1 {
+		return delay
+	}
+
+	delay = time.Duration(float64(delay) * p.opts.Multiplier)
+
+	if p.opts.MaxInterval > 0 {
+		delay = min(delay, p.opts.MaxInterval)
+	}
+
+	return delay
+}
+
+// stopped returns the error returned when polling stops due to the given error
+// of the context or the deadline.
+func (p *pollState[T]) stopped(ctx context.Context, err error) error {
+	if errors.Is(err, errPollDeadline) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &PollTimeoutError[T]{
+			Attempts:  p.attempts,
+			Elapsed:   p.opts.Clock.Now().Sub(p.start),
+			LastState: p.last,
+			LastErr:   p.lastErr,
+		}
+	}
+
+	return err
+}
+
+// pollOptions returns the options used to poll at the poll interval of the client,
+// e.g. by WaitFor functions without a timeout.
+func pollOptions[T any](client *Client) *PollOptions[T] {
+	return &PollOptions[T]{
+		Interval: client.pollInterval,
+	}
+}
+
+// waitForOptions returns the options used by WaitFor functions, polling at the poll
+// interval of the client until the given timeout elapses. A timeout of zero or less
+// has already elapsed, as it did when WaitFor functions used context.WithTimeout.
+func waitForOptions[T any](client *Client, timeoutSeconds int) *PollOptions[T] {
+	opts := pollOptions[T](client)
+
+	opts.Timeout = time.Duration(timeoutSeconds) * time.Second
+	if timeoutSeconds <= 0 {
+		opts.Timeout = -1
+	}
+
+	return opts
+}
+
+// wrapWaitForError adds the given description to errors returned by Poll when polling
+// was stopped by the timeout or context, leaving other errors, e.g. of requests, unchanged.
+func wrapWaitForError(err error, format string, args ...any) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return fmt.Errorf(format+": %w", append(args, err)...)
+	}
+
+	return err
+}
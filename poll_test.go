@@ -0,0 +1,205 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// testClock is a Clock advancing instantly when sleeping.
+type testClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+
+	return nil
+}
+
+// countingFetch returns a fetch function returning the number of times it has been called.
+func countingFetch() func(context.Context) (int, error) {
+	count := 0
+
+	return func(context.Context) (int, error) {
+		count++
+		return count, nil
+	}
+}
+
+func TestPoll_backoff(t *testing.T) {
+	clock := &testClock{}
+
+	var progress []PollProgress[int]
+
+	result, err := Poll(
+		context.Background(),
+		countingFetch(),
+		func(count int) bool { return count == 5 },
+		&PollOptions[int]{
+			Interval:    time.Second,
+			Multiplier:  2,
+			MaxInterval: 5 * time.Second,
+			Progress:    func(p PollProgress[int]) { progress = append(progress, p) },
+			Clock:       clock,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 5, result)
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, clock.sleeps)
+
+	require.Len(t, progress, 5)
+	require.Equal(t, PollProgress[int]{Attempt: 3, Elapsed: 7 * time.Second, State: 3}, progress[2])
+}
+
+func TestPoll_immediateJitter(t *testing.T) {
+	clock := &testClock{}
+
+	result, err := Poll(
+		context.Background(),
+		countingFetch(),
+		func(count int) bool { return count == 20 },
+		&PollOptions[int]{Interval: time.Second, Jitter: 0.5, Immediate: true, Clock: clock},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 20, result)
+	require.Len(t, clock.sleeps, 19)
+
+	for _, sleep := range clock.sleeps {
+		require.InDelta(t, time.Second, sleep, float64(time.Second/2))
+	}
+
+	require.NotEqual(t, clock.sleeps[0], clock.sleeps[1])
+}
+
+func TestPoll_timeout(t *testing.T) {
+	clock := &testClock{}
+
+	_, err := Poll(
+		context.Background(),
+		countingFetch(),
+		func(int) bool { return false },
+		&PollOptions[int]{Interval: 3 * time.Second, Timeout: 10 * time.Second, Clock: clock},
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, []time.Duration{3 * time.Second, 3 * time.Second, 3 * time.Second, time.Second}, clock.sleeps)
+
+	var timeoutErr *PollTimeoutError[int]
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, 3, timeoutErr.Attempts)
+	require.Equal(t, 3, timeoutErr.LastState)
+	require.Equal(t, 10*time.Second, timeoutErr.Elapsed)
+	require.EqualError(t, err, "context deadline exceeded after 3 polls in 10s")
+
+	// Negative timeouts have already elapsed
+	_, err = Poll(
+		context.Background(),
+		countingFetch(),
+		func(int) bool { return true },
+		&PollOptions[int]{Timeout: -1, Immediate: true, Clock: clock},
+	)
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Zero(t, timeoutErr.Attempts)
+}
+
+func TestPoll_errors(t *testing.T) {
+	clock := &testClock{}
+	fetchErr := errors.New("fetch failed")
+
+	// Errors are returned unless retried
+	_, err := Poll(
+		context.Background(),
+		func(context.Context) (int, error) { return 0, fetchErr },
+		func(int) bool { return true },
+		&PollOptions[int]{Clock: clock},
+	)
+	require.ErrorIs(t, err, fetchErr)
+	require.Len(t, clock.sleeps, 1)
+
+	_, err = Poll(
+		context.Background(),
+		func(context.Context) (int, error) { return 0, fetchErr },
+		func(int) bool { return true },
+		&PollOptions[int]{
+			Timeout: 5 * time.Second,
+			Retry:   func(err error) bool { return errors.Is(err, fetchErr) },
+			Clock:   clock,
+		},
+	)
+
+	var timeoutErr *PollTimeoutError[int]
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, fetchErr, timeoutErr.LastErr)
+	require.ErrorContains(t, err, "(last error: fetch failed)")
+
+	// Polling stops once the state can no longer meet the condition
+	state, err := Poll(
+		context.Background(),
+		countingFetch(),
+		func(int) bool { return false },
+		&PollOptions[int]{
+			Failure: func(count int) error {
+				if count == 2 {
+					return fetchErr
+				}
+
+				return nil
+			},
+			Clock: clock,
+		},
+	)
+	require.ErrorIs(t, err, fetchErr)
+	require.Equal(t, 2, state)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = Poll(ctx, countingFetch(), func(int) bool { return true }, &PollOptions[int]{Clock: clock})
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitFor_poll(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/123/backups/456"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, InstanceSnapshot{ID: 456, Status: SnapshotFailed}))
+
+	snapshot, err := client.WaitForSnapshotStatus(context.Background(), 123, 456, SnapshotSuccessful, 5)
+	require.EqualError(t, err, "Instance 123 Snapshot 456 has failed")
+	require.Equal(t, SnapshotFailed, snapshot.Status)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, Instance{ID: 123, Status: InstanceOffline}))
+
+	_, err = client.WaitForInstanceStatus(context.Background(), 123, InstanceRunning, 1)
+	require.ErrorContains(t, err, "Error waiting for Instance 123 status running: context deadline exceeded after")
+
+	var timeoutErr *PollTimeoutError[*Instance]
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, InstanceOffline, timeoutErr.LastState.Status)
+
+	// A timeout of zero has already elapsed
+	calls := httpmock.GetTotalCallCount()
+
+	_, err = client.WaitForInstanceStatus(context.Background(), 123, InstanceRunning, 0)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, calls, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,265 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ClientPoolOptions configures a ClientPool.
+type ClientPoolOptions struct {
+	// Transport is the http.RoundTripper shared by all clients in the pool.
+	// Defaults to a clone of http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// RateLimiter is shared by all clients in the pool if not nil.
+	RateLimiter *RateLimiter
+
+	// MaxConcurrency limits the number of clients used concurrently by ForEach.
+	// If zero, the number of clients used concurrently is not limited.
+	MaxConcurrency int
+
+	// Configure is called with each client created by the pool, e.g. to
+	// configure retries or logging.
+	Configure func(client *Client)
+}
+
+// ClientPool manages one Client per config profile or child account.
+// Clients are created on first use, share a single transport (and its
+// connection pool) and are safe for concurrent use.
+type ClientPool struct {
+	mu sync.Mutex
+
+	opts      ClientPoolOptions
+	transport http.RoundTripper
+
+	profiles map[string]ConfigProfile
+	clients  map[string]*Client
+}
+
+// ClientPoolError is returned by ClientPool.ForEach for each client that failed.
+type ClientPoolError struct {
+	// Name is the name of the profile or child account the error occurred for.
+	Name string
+	Err  error
+}
+
+func (e *ClientPoolError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Name, e.Err)
+}
+
+func (e *ClientPoolError) Unwrap() error {
+	return e.Err
+}
+
+// NewClientPool creates a ClientPool for the given config profiles.
+// Profile names are case-insensitive.
+func NewClientPool(profiles map[string]ConfigProfile, opts *ClientPoolOptions) *ClientPool {
+	p := &ClientPool{
+		profiles: make(map[string]ConfigProfile, len(profiles)),
+		clients:  make(map[string]*Client),
+	}
+
+	if opts != nil {
+		p.opts = *opts
+	}
+
+	p.transport = p.opts.Transport
+	if p.transport == nil {
+		p.transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	for name, profile := range profiles {
+		p.profiles[strings.ToLower(name)] = profile
+	}
+
+	return p
+}
+
+// NewClientPoolFromConfig creates a ClientPool for every profile in the Linode config
+// file at the given path. If path is empty, the default config paths are searched.
+func NewClientPoolFromConfig(path string, opts *ClientPoolOptions) (*ClientPool, error) {
+	if path == "" {
+		var err error
+		if path, err = resolveValidConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	profiles, err := loadConfigProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientPool(profiles, opts), nil
+}
+
+// Profiles returns the sorted names of the profiles in the pool that have a token.
+func (p *ClientPool) Profiles() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	result := make([]string, 0, len(p.profiles))
+
+	for name, profile := range p.profiles {
+		if profile.APIToken != "" {
+			result = append(result, name)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// Client returns the client for the given profile, creating it if necessary.
+func (p *ClientPool) Client(profile string) (*Client, error) {
+	name := strings.ToLower(profile)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.clients[name]; ok {
+		return client, nil
+	}
+
+	config, ok := p.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %s does not exist", name)
+	}
+
+	client, err := p.newClient(name, config)
+	if err != nil {
+		return nil, err
+	}
+
+	p.clients[name] = client
+
+	return client, nil
+}
+
+// ChildClient returns the client for the child account with the given EUUID, creating it
+// if necessary. The client is authenticated using proxy tokens minted by the client of the
+// given parent profile (see NewChildAccountTokenSource), which are replaced when they are
+// about to expire. The first token is minted using the given context.
+// NOTE: Parent/Child related features may not be generally available.
+func (p *ClientPool) ChildClient(ctx context.Context, parentProfile, euuid string) (*Client, error) {
+	parent, err := p.Client(parentProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.ToLower(parentProfile) + "/" + euuid
+
+	p.mu.Lock()
+	client, ok := p.clients[name]
+	p.mu.Unlock()
+
+	if ok {
+		return client, nil
+	}
+
+	source := NewChildAccountTokenSource(parent, euuid)
+	if _, err := source.Token(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another caller may have created the client while the token was minted
+	if client, ok := p.clients[name]; ok {
+		return client, nil
+	}
+
+	client, err = p.newClient(name, p.profiles[strings.ToLower(parentProfile)])
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetTokenSource(source)
+	p.clients[name] = client
+
+	return client, nil
+}
+
+// newClient creates a client for the given profile using the shared transport.
+// Profiles with their own TLS or proxy settings use a clone of the shared transport
+// (see SetClientOptions).
+func (p *ClientPool) newClient(name string, profile ConfigProfile) (*Client, error) {
+	client := NewClient(&http.Client{Transport: p.transport})
+
+	client.configProfiles = map[string]ConfigProfile{name: profile}
+	if err := client.UseProfile(name); err != nil {
+		return nil, err
+	}
+
+	if p.opts.RateLimiter != nil {
+		client.SetRateLimiter(p.opts.RateLimiter)
+	}
+
+	if p.opts.Configure != nil {
+		p.opts.Configure(&client)
+	}
+
+	return &client, nil
+}
+
+// ForEach calls fn concurrently with the client of each profile that has a token,
+// limited by ClientPoolOptions.MaxConcurrency. The context passed to fn is cancelled
+// once ForEach returns. Errors are returned as a joined error containing a
+// *ClientPoolError for each failed profile.
+func (p *ClientPool) ForEach(ctx context.Context, fn func(ctx context.Context, profile string, client *Client) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	profiles := p.Profiles()
+
+	var (
+		wg     sync.WaitGroup
+		errs   = make([]error, len(profiles))
+		tokens chan struct{}
+	)
+
+	if p.opts.MaxConcurrency > 0 {
+		tokens = make(chan struct{}, p.opts.MaxConcurrency)
+	}
+
+	for i, profile := range profiles {
+		if tokens != nil {
+			select {
+			case tokens <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = &ClientPoolError{Name: profile, Err: ctx.Err()}
+				continue
+			}
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if tokens != nil {
+				defer func() { <-tokens }()
+			}
+
+			client, err := p.Client(profile)
+			if err == nil {
+				err = fn(ctx, profile, client)
+			}
+
+			if err != nil {
+				errs[i] = &ClientPoolError{Name: profile, Err: err}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
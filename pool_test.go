@@ -0,0 +1,140 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClientPool(t *testing.T) (*ClientPool, *httpmock.MockTransport) {
+	t.Helper()
+
+	transport := httpmock.NewMockTransport()
+	profile := ConfigProfile{APIURL: APIHost, APIVersion: APIVersion}
+
+	profiles := map[string]ConfigProfile{"default": profile}
+
+	for _, name := range []string{"Foo", "bar", "baz"} {
+		profile.APIToken = strings.ToLower(name) + "-token"
+		profiles[name] = profile
+	}
+
+	return NewClientPool(profiles, &ClientPoolOptions{
+		Transport:      transport,
+		MaxConcurrency: 2,
+		Configure: func(client *Client) {
+			client.SetRetryCount(0)
+		},
+	}), transport
+}
+
+func TestClientPool_Client(t *testing.T) {
+	pool, _ := newTestClientPool(t)
+
+	require.Equal(t, []string{"bar", "baz", "foo"}, pool.Profiles())
+
+	client, err := pool.Client("FOO")
+	require.NoError(t, err)
+	require.Equal(t, "Bearer foo-token", client.resty.Header.Get("Authorization"))
+	require.Equal(t, "foo", client.selectedProfile)
+
+	// Clients are created once per profile
+	cached, err := pool.Client("foo")
+	require.NoError(t, err)
+	require.Same(t, client, cached)
+
+	// Profiles without a token cannot be used
+	_, err = pool.Client("default")
+	require.Error(t, err)
+
+	_, err = pool.Client("missing")
+	require.Error(t, err)
+}
+
+func TestClientPool_ForEach(t *testing.T) {
+	pool, transport := newTestClientPool(t)
+
+	transport.RegisterResponder("GET", "https://api.linode.com/v4/profile",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") == "Bearer baz-token" {
+				return httpmock.NewJsonResponse(http.StatusUnauthorized, APIError{
+					Errors: []APIErrorReason{{Reason: "Invalid Token"}},
+				})
+			}
+
+			username := strings.TrimSuffix(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "), "-token")
+			return httpmock.NewJsonResponse(http.StatusOK, Profile{Username: username})
+		})
+
+	var (
+		mu        sync.Mutex
+		usernames = make(map[string]string)
+	)
+
+	err := pool.ForEach(context.Background(), func(ctx context.Context, profile string, client *Client) error {
+		result, err := client.GetProfile(ctx)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		usernames[profile] = result.Username
+
+		return nil
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrUnauthorized))
+
+	var poolErr *ClientPoolError
+	require.True(t, errors.As(err, &poolErr))
+	require.Equal(t, "baz", poolErr.Name)
+
+	require.Equal(t, map[string]string{"foo": "foo", "bar": "bar"}, usernames)
+}
+
+func TestClientPool_ChildClient(t *testing.T) {
+	pool, transport := newTestClientPool(t)
+
+	expiry := time.Now().Add(time.Hour).UTC().Format("2006-01-02T15:04:05")
+
+	transport.RegisterResponder("POST", "https://api.linode.com/v4/account/child-accounts/child-euuid/token",
+		httpmock.NewStringResponder(http.StatusOK, `{"token": "child-token", "expiry": "`+expiry+`"}`))
+
+	transport.RegisterResponder("GET", "https://api.linode.com/v4/profile",
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "Bearer child-token", req.Header.Get("Authorization"))
+			return httpmock.NewJsonResponse(http.StatusOK, Profile{Username: "child"})
+		})
+
+	client, err := pool.ChildClient(context.Background(), "foo", "child-euuid")
+	require.NoError(t, err)
+
+	cached, err := pool.ChildClient(context.Background(), "foo", "child-euuid")
+	require.NoError(t, err)
+	require.Same(t, client, cached)
+
+	for range 2 {
+		profile, err := client.GetProfile(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "child", profile.Username)
+	}
+
+	// The token is only minted once until it is about to expire
+	require.Equal(t, 3, transport.GetTotalCallCount())
+
+	// Child clients are not created if the first token cannot be minted
+	transport.RegisterResponder("POST", "https://api.linode.com/v4/account/child-accounts/other-euuid/token",
+		httpmock.NewStringResponder(http.StatusForbidden, `{"errors": [{"reason": "Unauthorized"}]}`))
+
+	_, err = pool.ChildClient(context.Background(), "foo", "other-euuid")
+	require.ErrorIs(t, err, ErrForbidden)
+}
@@ -160,6 +160,8 @@ func (c *Client) GetPostgresDatabase(ctx context.Context, databaseID int) (*Post
 
 // CreatePostgresDatabase creates a new Postgres Database using the createOpts as configuration, returns the new Postgres Database
 func (c *Client) CreatePostgresDatabase(ctx context.Context, opts PostgresCreateOptions) (*PostgresDatabase, error) {
+	c.applyProfileDefaults(&opts)
+
 	e := "databases/postgresql/instances"
 	response, err := doPOSTRequest[PostgresDatabase](ctx, c, e, opts)
 	return response, err
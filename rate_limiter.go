@@ -0,0 +1,359 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	rateLimitLimitHeaderName     = "X-RateLimit-Limit"
+	rateLimitRemainingHeaderName = "X-RateLimit-Remaining"
+	rateLimitResetHeaderName     = "X-RateLimit-Reset"
+)
+
+// RateLimitClass configures the token bucket used to limit
+// requests to a class of API endpoints.
+type RateLimitClass struct {
+	// Name identifies the class, e.g. "object-storage".
+	Name string
+
+	// Match returns whether a request with the given method and endpoint
+	// (e.g. "linode/instances") belongs to this class.
+	// If nil, all requests will match. As such requests may be subject to
+	// different limits on the API, the bucket of a class without a Match
+	// function is not adapted to the X-RateLimit-* headers of responses.
+	Match func(method, endpoint string) bool
+
+	// Limit is the number of requests allowed per Window.
+	Limit int
+
+	// Window is the period over which Limit requests are allowed.
+	Window time.Duration
+
+	// Burst is the maximum number of requests that can be made at once.
+	// Defaults to Limit.
+	Burst int
+}
+
+// DefaultRateLimitClasses returns conservative rate limit classes modeled after the
+// Linode API's default limits. These should be adjusted to match your account's limits.
+func DefaultRateLimitClasses() []RateLimitClass {
+	return []RateLimitClass{
+		{
+			Name:   "linode-create",
+			Match:  MatchRateLimitEndpoint(http.MethodPost, "linode/instances"),
+			Limit:  10,
+			Window: 30 * time.Second,
+		},
+		{
+			Name:   "object-storage",
+			Match:  MatchRateLimitPrefix("", "object-storage"),
+			Limit:  750,
+			Window: time.Minute,
+		},
+		{
+			Name:   "default",
+			Limit:  800,
+			Window: time.Minute,
+		},
+	}
+}
+
+// MatchRateLimitEndpoint returns a RateLimitClass matcher for requests to exactly
+// the given endpoint. If method is empty, all methods will match.
+func MatchRateLimitEndpoint(method, endpoint string) func(string, string) bool {
+	endpoint = strings.Trim(endpoint, "/")
+
+	return func(m, e string) bool {
+		return (method == "" || strings.EqualFold(method, m)) && e == endpoint
+	}
+}
+
+// MatchRateLimitPrefix returns a RateLimitClass matcher for requests to the given
+// endpoint and any of its sub-endpoints. If method is empty, all methods will match.
+func MatchRateLimitPrefix(method, prefix string) func(string, string) bool {
+	prefix = strings.Trim(prefix, "/")
+
+	return func(m, e string) bool {
+		return (method == "" || strings.EqualFold(method, m)) &&
+			(e == prefix || strings.HasPrefix(e, prefix+"/"))
+	}
+}
+
+// RateLimiter is a client-side token bucket rate limiter for API requests.
+// Requests are limited by the bucket of the first RateLimitClass they match,
+// and requests matching no class are not limited.
+//
+// The limiter adapts to the X-RateLimit-* headers returned by the API
+// for requests matching a class with a Match function.
+// A RateLimiter is safe for concurrent use and may be shared between clients.
+type RateLimiter struct {
+	classes []RateLimitClass
+	buckets []*tokenBucket
+}
+
+// NewRateLimiter creates a new RateLimiter using the given classes.
+// If no classes are given, DefaultRateLimitClasses will be used.
+func NewRateLimiter(classes ...RateLimitClass) *RateLimiter {
+	if len(classes) == 0 {
+		classes = DefaultRateLimitClasses()
+	}
+
+	result := &RateLimiter{
+		classes: classes,
+		buckets: make([]*tokenBucket, len(classes)),
+	}
+
+	for i, class := range classes {
+		result.buckets[i] = newTokenBucket(class)
+	}
+
+	return result
+}
+
+// Wait blocks until a request with the given method and endpoint may be sent,
+// or the given context is cancelled.
+func (l *RateLimiter) Wait(ctx context.Context, method, endpoint string) error {
+	bucket := l.bucket(method, endpoint)
+	if bucket == nil {
+		return nil
+	}
+
+	return bucket.wait(ctx)
+}
+
+// Observe adapts the limiter to the X-RateLimit-* headers of a response to
+// a request with the given method and endpoint.
+func (l *RateLimiter) Observe(method, endpoint string, header http.Header) {
+	class, bucket := l.class(method, endpoint)
+	if bucket == nil || header == nil {
+		return
+	}
+
+	// The headers describe the limit of the endpoint that answered,
+	// which may not apply to the other requests of a catch-all class
+	if class.Match == nil {
+		return
+	}
+
+	status := parseRateLimitStatus(header)
+	if status == nil {
+		return
+	}
+
+	bucket.observe(status.Limit, status.Remaining, status.Reset)
+}
+
+func (l *RateLimiter) bucket(method, endpoint string) *tokenBucket {
+	_, bucket := l.class(method, endpoint)
+	return bucket
+}
+
+// class returns the first class matching the given request and its bucket.
+func (l *RateLimiter) class(method, endpoint string) (RateLimitClass, *tokenBucket) {
+	for i, class := range l.classes {
+		if class.Match == nil || class.Match(method, endpoint) {
+			return class, l.buckets[i]
+		}
+	}
+
+	return RateLimitClass{}, nil
+}
+
+// tokenBucket is a single token bucket used by the RateLimiter.
+type tokenBucket struct {
+	mu sync.Mutex
+
+	// The configured burst, which capacity is restored to
+	// once the limit reported by the API resets
+	burst         float64
+	window        time.Duration
+	capacity      float64
+	capacityReset time.Time
+
+	tokens float64
+	// Tokens refilled per second
+	rate       float64
+	lastRefill time.Time
+
+	// Requests are blocked until this time if the API
+	// reports that no requests remain
+	blockedUntil time.Time
+}
+
+func newTokenBucket(class RateLimitClass) *tokenBucket {
+	burst := class.Burst
+	if burst <= 0 {
+		burst = class.Limit
+	}
+
+	window := class.Window
+	if window <= 0 {
+		window = time.Second
+	}
+
+	return &tokenBucket{
+		burst:      float64(burst),
+		window:     window,
+		capacity:   float64(burst),
+		tokens:     float64(burst),
+		rate:       float64(class.Limit) / window.Seconds(),
+		lastRefill: time.Now(),
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := b.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token from the bucket if one is available, otherwise
+// it returns the time to wait before trying again.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+
+	if now.Before(b.blockedUntil) {
+		return b.blockedUntil.Sub(now)
+	}
+
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	if b.rate <= 0 {
+		// The bucket will never refill, so wait for the next observed reset
+		return time.Second
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	b.lastRefill = now
+
+	if !b.capacityReset.IsZero() && !now.Before(b.capacityReset) {
+		b.capacity = b.burst
+		b.capacityReset = time.Time{}
+	}
+
+	b.tokens += elapsed * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+}
+
+func (b *tokenBucket) observe(limit, remaining int, reset time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+
+	b.refill(now)
+
+	// Never allow more requests than the API reports are remaining,
+	// until the reported limit resets
+	if limit > 0 && float64(limit) < b.capacity {
+		b.capacity = float64(limit)
+
+		b.capacityReset = reset
+		if !reset.After(now) {
+			b.capacityReset = now.Add(b.window)
+		}
+	}
+
+	if float64(remaining) < b.tokens {
+		b.tokens = float64(remaining)
+	}
+
+	if remaining <= 0 && reset.After(now) {
+		b.blockedUntil = reset
+	}
+}
+
+// rateLimitState holds the rate limiter shared between copies of a Client.
+type rateLimitState struct {
+	limiter atomic.Pointer[RateLimiter]
+}
+
+// SetRateLimiter sets the RateLimiter used to limit requests made by this client.
+// Passing nil disables client-side rate limiting.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) *Client {
+	c.rateLimit.limiter.Store(limiter)
+	return c
+}
+
+// configureRateLimiting registers the hooks used to rate limit requests
+// made through both resty and the net/http execution path.
+//
+// The hooks reference the shared rate limit state rather than the Client
+// so they remain valid for copies of the Client.
+func (c *Client) configureRateLimiting() {
+	state := c.rateLimit
+	rc := c.resty
+
+	rc.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		return state.wait(req.Context(), req.Method, requestEndpoint(rc.BaseURL, req.URL))
+	})
+
+	rc.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+		if resp.Request != nil {
+			state.observe(resp.Request.Method, requestEndpoint(rc.BaseURL, resp.Request.URL), resp.Header())
+		}
+		return nil
+	})
+
+	c.http.httpOnBeforeRequest(func(req *http.Request) error {
+		return state.wait(req.Context(), req.Method, requestEndpoint(rc.BaseURL, req.URL.String()))
+	})
+
+	c.http.httpOnAfterResponse(func(resp *http.Response) error {
+		if resp.Request != nil {
+			state.observe(resp.Request.Method, requestEndpoint(rc.BaseURL, resp.Request.URL.String()), resp.Header)
+		}
+		return nil
+	})
+}
+
+func (s *rateLimitState) wait(ctx context.Context, method, endpoint string) error {
+	limiter := s.limiter.Load()
+	if limiter == nil {
+		return nil
+	}
+
+	return limiter.Wait(ctx, method, endpoint)
+}
+
+func (s *rateLimitState) observe(method, endpoint string, header http.Header) {
+	limiter := s.limiter.Load()
+	if limiter == nil {
+		return
+	}
+
+	limiter.Observe(method, endpoint, header)
+}
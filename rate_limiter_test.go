@@ -0,0 +1,181 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := NewRateLimiter(
+		RateLimitClass{
+			Name:   "linode-create",
+			Match:  MatchRateLimitEndpoint(http.MethodPost, "linode/instances"),
+			Limit:  1,
+			Window: time.Hour,
+		},
+	)
+
+	require.NoError(t, limiter.Wait(context.Background(), http.MethodPost, "linode/instances"))
+
+	// Requests that don't match any class should never be limited
+	for range 5 {
+		require.NoError(t, limiter.Wait(context.Background(), http.MethodGet, "linode/instances"))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, limiter.Wait(ctx, http.MethodPost, "linode/instances"), context.DeadlineExceeded)
+}
+
+func TestRateLimiter_Refill(t *testing.T) {
+	limiter := NewRateLimiter(
+		RateLimitClass{
+			Limit:  10,
+			Window: 100 * time.Millisecond,
+			Burst:  1,
+		},
+	)
+
+	start := time.Now()
+
+	for range 3 {
+		require.NoError(t, limiter.Wait(context.Background(), http.MethodGet, "regions"))
+	}
+
+	// The first request uses the burst, the remaining two must wait for a refill
+	require.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+}
+
+func TestRateLimiter_Observe(t *testing.T) {
+	limiter := NewRateLimiter(
+		RateLimitClass{
+			Match:  MatchRateLimitPrefix("", "object-storage"),
+			Limit:  100,
+			Window: time.Second,
+		},
+	)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "100")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+
+	limiter.Observe(http.MethodGet, "object-storage/buckets", header)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, limiter.Wait(ctx, http.MethodGet, "object-storage/keys"), context.DeadlineExceeded)
+}
+
+func TestRateLimiter_ObserveCatchAll(t *testing.T) {
+	limiter := NewRateLimiter(
+		RateLimitClass{
+			Name:   "default",
+			Limit:  100,
+			Window: time.Second,
+		},
+	)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "5")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+
+	// The exhausted limit of one endpoint should not block requests to others
+	limiter.Observe(http.MethodGet, "account/events", header)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, limiter.Wait(ctx, http.MethodGet, "regions"))
+	require.Equal(t, float64(100), limiter.buckets[0].capacity)
+}
+
+func TestRateLimiter_ObserveCapacityReset(t *testing.T) {
+	limiter := NewRateLimiter(
+		RateLimitClass{
+			Match:  MatchRateLimitPrefix("", "object-storage"),
+			Limit:  10,
+			Window: 50 * time.Millisecond,
+		},
+	)
+
+	bucket := limiter.buckets[0]
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "2")
+	header.Set("X-RateLimit-Remaining", "2")
+
+	limiter.Observe(http.MethodGet, "object-storage/buckets", header)
+
+	bucket.mu.Lock()
+	require.Equal(t, float64(2), bucket.capacity)
+	bucket.mu.Unlock()
+
+	// The configured burst should be restored once the reported limit resets
+	require.Eventually(t, func() bool {
+		bucket.mu.Lock()
+		defer bucket.mu.Unlock()
+
+		bucket.refill(time.Now())
+
+		return bucket.capacity == 10
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	client.SetRateLimiter(NewRateLimiter(
+		RateLimitClass{
+			Match:  MatchRateLimitPrefix(http.MethodGet, "linode/instances"),
+			Limit:  100,
+			Window: time.Second,
+		},
+	))
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/linode/instances/123"),
+		func(request *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(200, Instance{ID: 123})
+			if err != nil {
+				return nil, err
+			}
+
+			resp.Header.Set("X-RateLimit-Remaining", "0")
+			resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+
+			return resp, nil
+		})
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.GetInstance(ctx, 123)
+	require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	// The same limiter should apply to the net/http execution path
+	client.UseHTTPTransport(nil)
+
+	_, err = client.GetInstance(ctx, 123)
+	require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	client.SetRateLimiter(nil)
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
@@ -78,35 +78,16 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]Region), nil
-	}
-
-	response, err := getPaginatedResults[Region](ctx, c, "regions", opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, endpoint, &cacheExpiryTime, func(ctx context.Context) ([]Region, error) {
+		return getPaginatedResults[Region](ctx, c, "regions", opts)
+	})
 }
 
 // GetRegion gets the template with the provided ID. This endpoint is cached by default.
 func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error) {
 	e := formatAPIPath("regions/%s", regionID)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(Region)
-		return &result, nil
-	}
-
-	response, err := doGETRequest[Region](ctx, c, e)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(e, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, e, &cacheExpiryTime, func(ctx context.Context) (*Region, error) {
+		return doGETRequest[Region](ctx, c, e)
+	})
 }
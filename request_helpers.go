@@ -4,8 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
+	"path"
 	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 )
 
 // paginatedResponse represents a single response from a paginated
@@ -26,14 +32,22 @@ func getPaginatedResults[T any](
 	endpoint string,
 	opts *ListOptions,
 ) ([]T, error) {
-	var resultType paginatedResponse[T]
-
 	result := make([]T, 0)
 
 	if opts == nil {
 		opts = &ListOptions{PageOptions: &PageOptions{Page: 0}}
 	}
 
+	// Background cache revalidations must not mutate the caller's ListOptions
+	if isCacheRevalidation(ctx) {
+		optsCopy := *opts
+		if opts.PageOptions != nil {
+			pageOptsCopy := *opts.PageOptions
+			optsCopy.PageOptions = &pageOptsCopy
+		}
+		opts = &optsCopy
+	}
+
 	if opts.PageOptions == nil {
 		opts.PageOptions = &PageOptions{Page: 0}
 	}
@@ -44,22 +58,20 @@ func getPaginatedResults[T any](
 		// Override the page to be applied in applyListOptionsToRequest(...)
 		opts.Page = page
 
-		// This request object cannot be reused for each page request
+		// This response object cannot be reused for each page request
 		// because it can lead to possible data corruption
-		req := client.R(ctx).SetResult(resultType)
-
-		// Apply all user-provided list options to the request
-		if err := applyListOptionsToRequest(opts, req); err != nil {
+		var response paginatedResponse[T]
+
+		if err := client.doRequest(
+			ctx,
+			http.MethodGet,
+			endpoint,
+			RequestParams{Response: &response},
+			opts,
+		); err != nil {
 			return err
 		}
 
-		res, err := coupleAPIErrors(req.Get(endpoint))
-		if err != nil {
-			return err
-		}
-
-		response := res.Result().(*paginatedResponse[T])
-
 		opts.Page = page
 		opts.Pages = response.Pages
 		opts.Results = response.Results
@@ -87,6 +99,21 @@ func getPaginatedResults[T any](
 		return result, nil
 	}
 
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = client.pageConcurrency
+	}
+
+	// Get the rest of the pages in parallel
+	if concurrency > 1 && opts.Pages > 2 {
+		remaining, err := getPagesConcurrently[T](ctx, client, endpoint, opts, concurrency)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(result, remaining...), nil
+	}
+
 	// Get the rest of the pages
 	for page := 2; page <= opts.Pages; page++ {
 		if err := handlePage(page); err != nil {
@@ -97,6 +124,114 @@ func getPaginatedResults[T any](
 	return result, nil
 }
 
+// getPagesConcurrently requests the pages following the first page of the given
+// paginated endpoint, with at most the given number of requests in flight, and
+// returns their results in order. Once a request fails, the requests in flight
+// are cancelled and its error is returned.
+func getPagesConcurrently[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+	concurrency int,
+) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Each request records its own metadata, as they can't share the caller's
+	target := responseMetadataFromContext(ctx)
+
+	responses := make([]paginatedResponse[T], opts.Pages-1)
+	metadata := make([]ResponseMetadata, len(responses))
+	semaphore := make(chan struct{}, concurrency)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		failed   int
+	)
+
+	for i := range responses {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			// The ListOptions cannot be shared between requests
+			// as the page is applied to each request from them
+			pageOpts := *opts
+			pageOpts.PageOptions = &PageOptions{Page: i + 2}
+
+			pageCtx := ctx
+			if target != nil {
+				pageCtx = ContextWithResponseMetadata(ctx, &metadata[i])
+			}
+
+			if err := client.doRequest(
+				pageCtx,
+				http.MethodGet,
+				endpoint,
+				RequestParams{Response: &responses[i]},
+				&pageOpts,
+			); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					failed = i
+				}
+				mu.Unlock()
+
+				cancel()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		if target != nil {
+			*target = metadata[failed]
+		}
+
+		return nil, firstErr
+	}
+
+	// The caller's context was cancelled before all requests were made
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	last := responses[len(responses)-1]
+
+	opts.Page = opts.Pages
+	opts.Pages = last.Pages
+	opts.Results = last.Results
+
+	if target != nil {
+		*target = metadata[len(metadata)-1]
+	}
+
+	result := make([]T, 0, len(responses)*len(responses[0].Data))
+	for _, response := range responses {
+		result = append(result, response.Data...)
+	}
+
+	return result, nil
+}
+
 // doGETRequest runs a GET request using the given client and API endpoint,
 // and returns the result
 func doGETRequest[T any](
@@ -106,13 +241,17 @@ func doGETRequest[T any](
 ) (*T, error) {
 	var resultType T
 
-	req := client.R(ctx).SetResult(&resultType)
-	r, err := coupleAPIErrors(req.Get(endpoint))
-	if err != nil {
+	if err := client.doRequest(
+		ctx,
+		http.MethodGet,
+		endpoint,
+		RequestParams{Response: &resultType},
+		nil,
+	); err != nil {
 		return nil, err
 	}
 
-	return r.Result().(*T), nil
+	return &resultType, nil
 }
 
 // doPOSTRequest runs a PUT request using the given client, API endpoint,
@@ -131,22 +270,17 @@ func doPOSTRequest[T, O any](
 		return nil, fmt.Errorf("invalid number of options: %d", len(options))
 	}
 
-	req := client.R(ctx).SetResult(&resultType)
+	params := RequestParams{Response: &resultType}
 
 	if numOpts > 0 && !isNil(options[0]) {
-		body, err := json.Marshal(options[0])
-		if err != nil {
-			return nil, err
-		}
-		req.SetBody(string(body))
+		params.Body = options[0]
 	}
 
-	r, err := coupleAPIErrors(req.Post(endpoint))
-	if err != nil {
+	if err := client.doRequest(ctx, http.MethodPost, endpoint, params, nil); err != nil {
 		return nil, err
 	}
 
-	return r.Result().(*T), nil
+	return &resultType, nil
 }
 
 // doPUTRequest runs a PUT request using the given client, API endpoint,
@@ -165,22 +299,17 @@ func doPUTRequest[T, O any](
 		return nil, fmt.Errorf("invalid number of options: %d", len(options))
 	}
 
-	req := client.R(ctx).SetResult(&resultType)
+	params := RequestParams{Response: &resultType}
 
 	if numOpts > 0 && !isNil(options[0]) {
-		body, err := json.Marshal(options[0])
-		if err != nil {
-			return nil, err
-		}
-		req.SetBody(string(body))
+		params.Body = options[0]
 	}
 
-	r, err := coupleAPIErrors(req.Put(endpoint))
-	if err != nil {
+	if err := client.doRequest(ctx, http.MethodPut, endpoint, params, nil); err != nil {
 		return nil, err
 	}
 
-	return r.Result().(*T), nil
+	return &resultType, nil
 }
 
 // doDELETERequest runs a DELETE request using the given client
@@ -190,9 +319,107 @@ func doDELETERequest(
 	client *Client,
 	endpoint string,
 ) error {
-	req := client.R(ctx)
-	_, err := coupleAPIErrors(req.Delete(endpoint))
-	return err
+	return client.doRequest(ctx, http.MethodDelete, endpoint, RequestParams{}, nil)
+}
+
+// doRequest executes a request against the given API endpoint using resty,
+// or net/http if the client has been configured using UseHTTPTransport.
+// The response is decoded into params.Response and the given ListOptions
+// are applied to the request if not nil.
+// Cached responses affected by successful mutating requests are invalidated.
+// Mutating requests are recorded rather than sent if the client is in dry-run mode.
+func (c *Client) doRequest(
+	ctx context.Context,
+	method, endpoint string,
+	params RequestParams,
+	opts *ListOptions,
+) (err error) {
+	metadata := &ResponseMetadata{Method: method, Endpoint: endpoint}
+	correlationID := correlationIDFromContext(ctx)
+	start := time.Now()
+
+	if dryRun := c.dryRun.get(method, endpoint); dryRun != nil {
+		return dryRun.record(method, endpoint, params)
+	}
+
+	requestOpts := requestOptionsFromContext(ctx)
+
+	ctx, cancel := requestOpts.withTimeout(ctx)
+	defer cancel()
+
+	defer func() {
+		metadata.Duration = time.Since(start)
+
+		if err != nil {
+			err = withErrorMetadata(err, metadata)
+		}
+
+		if target := responseMetadataFromContext(ctx); target != nil {
+			*target = *metadata
+		}
+	}()
+
+	if c.useHTTP {
+		// The telemetry for this request is finished by httpClient.doRequest
+		ctx, _ := c.telemetry.startRequest(ctx, method, endpoint)
+		ctx = context.WithValue(ctx, requestMetadataKey{}, metadata)
+
+		if err := c.http.doRequest(
+			ctx,
+			method,
+			c.hostURL(requestOpts, endpoint),
+			params,
+			func(req *http.Request) error {
+				if correlationID != "" {
+					req.Header.Set(CorrelationIDHeaderName, correlationID)
+				}
+
+				requestOpts.applyHeaders(req.Header)
+
+				return applyListOptionsToHTTPRequest(opts, req)
+			},
+		); err != nil {
+			return err
+		}
+
+		c.invalidateCacheForRequest(method, endpoint)
+
+		return nil
+	}
+
+	req := c.R(ctx)
+
+	if correlationID != "" {
+		req.SetHeader(CorrelationIDHeaderName, correlationID)
+	}
+
+	if params.Response != nil {
+		req.SetResult(params.Response)
+	}
+
+	if params.Body != nil {
+		body, err := json.Marshal(params.Body)
+		if err != nil {
+			return err
+		}
+		req.SetBody(string(body))
+	}
+
+	// Apply all user-provided list options to the request
+	if err := applyListOptionsToRequest(opts, req); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(method, endpoint)
+	metadata.recordResty(resp)
+
+	if _, err := coupleAPIErrors(resp, err); err != nil {
+		return err
+	}
+
+	c.invalidateCacheForRequest(method, endpoint)
+
+	return nil
 }
 
 // formatAPIPath allows us to safely build an API request with path escaping
@@ -218,3 +445,34 @@ func isNil(i interface{}) bool {
 	v := reflect.ValueOf(i)
 	return v.Kind() == reflect.Ptr && v.IsNil()
 }
+
+// apiVersionPattern matches API version path segments, e.g. v4 and v4beta.
+var apiVersionPattern = regexp.MustCompile(`^v[0-9]+[a-zA-Z0-9]*$`)
+
+// requestEndpoint returns the API endpoint of the given request URL
+// relative to the given base URL.
+func requestEndpoint(baseURL, requestURL string) string {
+	absolute := false
+
+	if u, err := url.Parse(requestURL); err == nil {
+		absolute = u.IsAbs()
+		requestURL = u.Path
+	}
+
+	if base, err := url.Parse(baseURL); err == nil {
+		basePath := strings.TrimSuffix(base.Path, "/")
+
+		if endpoint, ok := strings.CutPrefix(requestURL, basePath+"/"); ok {
+			requestURL = endpoint
+		} else if endpoint, ok := strings.CutPrefix(requestURL, path.Dir(basePath)); ok {
+			// The API version may have been overridden using WithRequestOptions
+			version, rest, _ := strings.Cut(strings.TrimPrefix(endpoint, "/"), "/")
+
+			if absolute || apiVersionPattern.MatchString(version) {
+				requestURL = rest
+			}
+		}
+	}
+
+	return strings.Trim(requestURL, "/")
+}
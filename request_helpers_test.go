@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -247,6 +249,223 @@ func TestRequestHelpers_paginateSingle(t *testing.T) {
 	}
 }
 
+func TestRequestHelpers_paginateConcurrently(t *testing.T) {
+	const totalResults = 4123
+
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetPageConcurrency(2)
+
+	var (
+		mu          sync.Mutex
+		numRequests int
+		inFlight    int
+		maxInFlight int
+	)
+
+	responder := mockPaginatedResponse(buildPaginatedEntries(totalResults), &numRequests)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			mu.Lock()
+			inFlight++
+			maxInFlight = max(maxInFlight, inFlight)
+			mu.Unlock()
+
+			// Give other requests the chance to be made in parallel
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			inFlight--
+
+			return responder(request)
+		},
+	)
+
+	var metadata ResponseMetadata
+
+	opts := &ListOptions{PageSize: 500, Concurrency: 4}
+
+	response, err := getPaginatedResults[testResultType](
+		ContextWithResponseMetadata(context.Background(), &metadata),
+		client,
+		"/foo/bar",
+		opts,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, 9, numRequests)
+	require.Equal(t, 4, maxInFlight)
+	require.Equal(t, 9, opts.Page)
+	require.Equal(t, 9, opts.Pages)
+	require.Equal(t, http.StatusOK, metadata.StatusCode)
+	require.Len(t, response, totalResults)
+
+	for i, entry := range response {
+		require.Equal(t, i, entry.ID)
+		require.Equal(t, fmt.Sprintf("test-%d", i), *entry.Bar)
+	}
+
+	// The concurrency of the client applies unless overridden
+	mu.Lock()
+	numRequests, maxInFlight = 0, 0
+	mu.Unlock()
+
+	response, err = getPaginatedResults[testResultType](context.Background(), client, "/foo/bar", &ListOptions{PageSize: 500})
+	require.NoError(t, err)
+
+	require.Len(t, response, totalResults)
+	require.Equal(t, 2, maxInFlight)
+}
+
+func TestRequestHelpers_paginateConcurrentlyError(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetPageConcurrency(4)
+
+	var (
+		mu          sync.Mutex
+		numRequests int
+	)
+
+	responder := mockPaginatedResponse(buildPaginatedEntries(100), &numRequests)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "3" {
+				return httpmock.NewJsonResponse(http.StatusBadRequest, APIError{
+					Errors: []APIErrorReason{{Reason: "Invalid page"}},
+				})
+			}
+
+			// Requests in flight are cancelled by the failed request
+			if request.URL.Query().Get("page") != "1" {
+				<-request.Context().Done()
+
+				return nil, request.Context().Err()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			return responder(request)
+		},
+	)
+
+	_, err := getPaginatedResults[testResultType](context.Background(), client, "/foo/bar", nil)
+	require.ErrorContains(t, err, "Invalid page")
+
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.Code)
+}
+
+func TestRequestHelpers_httpTransport(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.UseHTTPTransport(nil)
+	client.SetToken("NOTANAPIKEY")
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			if request.Header.Get("Authorization") != "Bearer NOTANAPIKEY" {
+				t.Fatalf("unexpected authorization header: %s", request.Header.Get("Authorization"))
+			}
+
+			return httpmock.NewJsonResponse(200, &testResponse)
+		})
+
+	httpmock.RegisterRegexpResponder("PUT", testutil.MockRequestURL("/foo/bar"),
+		testutil.MockRequestBodyValidate(t, testResponse, testResponse))
+
+	httpmock.RegisterRegexpResponder("DELETE", testutil.MockRequestURL("/foo/bar"),
+		httpmock.NewStringResponder(200, ""))
+
+	result, err := doGETRequest[testResultType](context.Background(), client, "/foo/bar")
+	require.NoError(t, err)
+	require.Equal(t, testResponse, *result)
+
+	result, err = doPUTRequest[testResultType](context.Background(), client, "/foo/bar", testResponse)
+	require.NoError(t, err)
+	require.Equal(t, testResponse, *result)
+
+	require.NoError(t, doDELETERequest(context.Background(), client, "/foo/bar"))
+}
+
+func TestRequestHelpers_httpTransportPaginate(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.UseHTTPTransport(nil)
+
+	numRequests := 0
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			require.Equal(t, "{\"foo\": \"bar\"}", request.Header.Get("X-Filter"))
+
+			return mockPaginatedResponse(buildPaginatedEntries(12), &numRequests)(request)
+		},
+	)
+
+	response, err := getPaginatedResults[testResultType](
+		context.Background(),
+		client,
+		"/foo/bar",
+		&ListOptions{
+			Filter: "{\"foo\": \"bar\"}",
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, 4, numRequests)
+	require.Len(t, response, 12)
+}
+
+func TestRequestHelpers_httpTransportRetry(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.UseHTTPTransport(nil)
+	client.SetRetryWaitTime(0)
+
+	step := 0
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			step++
+
+			if step == 1 {
+				return httpmock.NewJsonResponse(http.StatusBadRequest, APIError{
+					Errors: []APIErrorReason{{Reason: "Linode busy."}},
+				})
+			}
+
+			return httpmock.NewJsonResponse(200, &testResponse)
+		})
+
+	result, err := doGETRequest[testResultType](context.Background(), client, "/foo/bar")
+	require.NoError(t, err)
+	require.Equal(t, testResponse, *result)
+	require.Equal(t, 2, step)
+}
+
+func TestRequestHelpers_httpTransportError(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.UseHTTPTransport(nil)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		httpmock.NewJsonResponderOrPanic(http.StatusNotFound, APIError{
+			Errors: []APIErrorReason{{Reason: "Not found"}},
+		}))
+
+	_, err := doGETRequest[testResultType](context.Background(), client, "/foo/bar")
+	require.Error(t, err)
+	require.True(t, IsNotFound(err))
+	require.Equal(t, "[404] Not found", err.Error())
+}
+
 func buildPaginatedEntries(numEntries int) []testResultType {
 	result := make([]testResultType, numEntries)
 
@@ -300,3 +519,28 @@ func mockPaginatedResponse(
 		)
 	}
 }
+
+// runForEachTransport runs the given test in a subtest for each way a Client can
+// execute requests: using resty, and using net/http (see UseHTTPTransport).
+// Each subtest uses a new mock client, so httpmock is reset between them.
+func runForEachTransport(t *testing.T, test func(t *testing.T, client *Client)) {
+	t.Helper()
+
+	for _, tc := range []struct {
+		name    string
+		useHTTP bool
+	}{
+		{name: "resty", useHTTP: false},
+		{name: "http", useHTTP: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := testutil.CreateMockClient(t, NewClient)
+
+			if tc.useHTTP {
+				client.UseHTTPTransport(nil)
+			}
+
+			test(t, client)
+		})
+	}
+}
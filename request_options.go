@@ -0,0 +1,161 @@
+package linodego
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RequestOptions configure individual requests made using a context
+// returned by WithRequestOptions.
+type RequestOptions struct {
+	// APIVersion overrides the API version of the client, e.g. v4beta.
+	// Responses are never cached when the API version is overridden.
+	APIVersion string
+
+	// SkipCache bypasses the response cache, meaning cached responses
+	// are not returned and responses are not added to the cache.
+	SkipCache bool
+
+	// Headers are additional headers sent with the request,
+	// taking precedence over the headers of the client.
+	Headers map[string]string
+
+	// RetryPolicy overrides the RetryPolicy of the client. Requests made
+	// using resty are additionally limited to the maximum number of
+	// attempts of the client's RetryPolicy.
+	RetryPolicy *RetryPolicy
+
+	// Timeout limits the time of the request, including all retries.
+	// It is not applied to requests created directly using R(...).
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of the given context that applies the given
+// options to requests made using it. Options already present in the context are
+// merged with the given options, which take precedence. For example:
+//
+//	ctx := linodego.WithRequestOptions(ctx, linodego.RequestOptions{APIVersion: "v4beta"})
+//	instance, err := client.GetInstance(ctx, instanceID)
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	if current := requestOptionsFromContext(ctx); current != nil {
+		opts = current.merge(opts)
+	}
+
+	return context.WithValue(ctx, requestOptionsKey{}, &opts)
+}
+
+func requestOptionsFromContext(ctx context.Context) *RequestOptions {
+	opts, _ := ctx.Value(requestOptionsKey{}).(*RequestOptions)
+	return opts
+}
+
+// merge returns a copy of o with the non-zero fields of other applied.
+func (o *RequestOptions) merge(other RequestOptions) RequestOptions {
+	result := *o
+
+	if other.APIVersion != "" {
+		result.APIVersion = other.APIVersion
+	}
+
+	result.SkipCache = result.SkipCache || other.SkipCache
+
+	if len(other.Headers) > 0 {
+		result.Headers = maps.Clone(result.Headers)
+		if result.Headers == nil {
+			result.Headers = make(map[string]string, len(other.Headers))
+		}
+
+		maps.Copy(result.Headers, other.Headers)
+	}
+
+	if other.RetryPolicy != nil {
+		result.RetryPolicy = other.RetryPolicy
+	}
+
+	if other.Timeout > 0 {
+		result.Timeout = other.Timeout
+	}
+
+	return result
+}
+
+// skipCache returns whether the response cache should be bypassed.
+func (o *RequestOptions) skipCache() bool {
+	return o != nil && (o.SkipCache || o.APIVersion != "")
+}
+
+// withTimeout applies the timeout of the options to the given context.
+func (o *RequestOptions) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o == nil || o.Timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, o.Timeout)
+}
+
+// baseURL returns the given base URL with the API version of the options applied.
+func (o *RequestOptions) baseURL(baseURL string) string {
+	if o == nil || o.APIVersion == "" {
+		return baseURL
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL
+	}
+
+	// The API version is the last segment of the base URL's path
+	u.Path = path.Join(path.Dir(strings.TrimSuffix(u.Path, "/")), o.APIVersion)
+	u.RawPath = ""
+
+	return u.String()
+}
+
+func (o *RequestOptions) applyHeaders(header http.Header) {
+	if o == nil {
+		return
+	}
+
+	for k, v := range o.Headers {
+		header.Set(k, v)
+	}
+}
+
+// forRequest returns the retry policy for the request with the given context.
+func (s *clientRetryState) forRequest(ctx context.Context) *RetryPolicy {
+	if opts := requestOptionsFromContext(ctx); opts != nil && opts.RetryPolicy != nil {
+		return opts.RetryPolicy
+	}
+
+	return s.get()
+}
+
+// configureRequestOptions registers the hook used to apply request options
+// to requests made through resty, including requests created using R(...).
+// Requests made through the net/http execution path are handled by doRequest.
+func (c *Client) configureRequestOptions() {
+	c.resty.OnBeforeRequest(func(rc *resty.Client, req *resty.Request) error {
+		opts := requestOptionsFromContext(req.Context())
+		if opts == nil {
+			return nil
+		}
+
+		opts.applyHeaders(req.Header)
+
+		// The URL is absolute once the request has been attempted
+		if u, err := url.Parse(req.URL); err == nil && !u.IsAbs() && opts.APIVersion != "" {
+			req.URL = strings.TrimSuffix(opts.baseURL(rc.BaseURL), "/") + "/" + strings.TrimPrefix(req.URL, "/")
+		}
+
+		return nil
+	})
+}
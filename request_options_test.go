@@ -0,0 +1,98 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RequestOptions(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		client.SetRetryWaitTime(0)
+
+		var (
+			path        string
+			header      http.Header
+			hasDeadline bool
+		)
+
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/regions/us-east"),
+			func(req *http.Request) (*http.Response, error) {
+				path, header = req.URL.Path, req.Header
+				_, hasDeadline = req.Context().Deadline()
+
+				return httpmock.NewJsonResponse(http.StatusOK, Region{ID: "us-east"})
+			})
+
+		ctx := WithRequestOptions(context.Background(), RequestOptions{
+			Headers: map[string]string{"X-Team": "infra"},
+			Timeout: time.Minute,
+		})
+		ctx = WithRequestOptions(ctx, RequestOptions{APIVersion: "v4beta"})
+
+		_, err := client.GetRegion(ctx, "us-east")
+		require.NoError(t, err)
+		require.Equal(t, "/v4beta/regions/us-east", path)
+		require.Equal(t, "infra", header.Get("X-Team"))
+		require.True(t, hasDeadline)
+
+		// Responses for overridden API versions are not cached
+		_, err = client.GetRegion(context.Background(), "us-east")
+		require.NoError(t, err)
+		require.Equal(t, "/v4/regions/us-east", path)
+
+		_, err = client.GetRegion(WithRequestOptions(context.Background(), RequestOptions{SkipCache: true}), "us-east")
+		require.NoError(t, err)
+		require.Equal(t, 3, httpmock.GetTotalCallCount())
+
+		// The retry policy of the request takes precedence over the client's
+		httpmock.Reset()
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/profile"),
+			httpmock.NewStringResponder(http.StatusServiceUnavailable, "{}"))
+
+		_, err = client.GetProfile(WithRequestOptions(context.Background(), RequestOptions{
+			RetryPolicy: &RetryPolicy{MaxAttempts: 2},
+		}))
+		require.Error(t, err)
+		require.Equal(t, 2, httpmock.GetTotalCallCount())
+	})
+}
+
+func TestClient_RequestOptions_R(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	httpmock.RegisterResponder("GET", "https://api.linode.com/v4beta/profile",
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "infra", req.Header.Get("X-Team"))
+			return httpmock.NewJsonResponse(http.StatusOK, Profile{})
+		})
+
+	ctx := WithRequestOptions(context.Background(), RequestOptions{
+		APIVersion: "v4beta",
+		Headers:    map[string]string{"X-Team": "infra"},
+	})
+
+	resp, err := client.R(ctx).Get("profile")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+}
+
+func TestRequestEndpoint(t *testing.T) {
+	base := "https://api.linode.com/v4"
+
+	require.Equal(t, "linode/instances", requestEndpoint(base, "https://api.linode.com/v4/linode/instances"))
+	require.Equal(t, "linode/instances", requestEndpoint(base, "https://api.linode.com/v4beta/linode/instances"))
+	require.Equal(t, "linode/instances", requestEndpoint(base+"/", "/v4/linode/instances/"))
+	require.Equal(t, "linode/instances", requestEndpoint(base, "/v4beta/linode/instances"))
+
+	// Relative endpoints with a leading slash are not mistaken for overridden API versions
+	require.Equal(t, "vpcs/123", requestEndpoint(base, "/vpcs/123"))
+	require.Equal(t, "account/betas", requestEndpoint(base, "/account/betas"))
+	require.Equal(t, "betas", requestEndpoint(base, "/betas"))
+	require.Equal(t, "volumes", requestEndpoint(base, "volumes"))
+}
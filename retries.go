@@ -2,7 +2,7 @@ package linodego
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
@@ -26,27 +26,140 @@ type RetryAfter resty.RetryAfterFunc
 
 // Configures resty to
 // lock until enough time has passed to retry the request as determined by the Retry-After response header.
-// If the Retry-After header is not set, we fall back to value of SetPollDelay.
+// If the Retry-After header is not set, the delay is determined by the client's RetryPolicy.
 func configureRetries(c *Client) {
 	c.resty.
-		SetRetryCount(defaultRetryCount).
 		AddRetryCondition(checkRetryConditionals(c)).
-		SetRetryAfter(respectRetryAfter)
+		SetRetryAfter(policyRetryAfter(c.retryState))
+
+	c.applyRetryPolicy()
 }
 
 func checkRetryConditionals(c *Client) func(*resty.Response, error) bool {
+	logState := c.logState
+	retryState := c.retryState
+	maintenance := c.maintenance
+	rc := c.resty
+
 	return func(r *resty.Response, err error) bool {
-		for _, retryConditional := range c.retryConditionals {
-			retry := retryConditional(r, err)
-			if retry {
-				log.Printf("[INFO] Received error %s - Retrying", r.Error())
-				return true
+		// A nil response means the request failed before it was sent,
+		// e.g. a before-request hook returned an error
+		if r == nil {
+			return false
+		}
+
+		retry := false
+
+		if maintenanceError := maintenance.retryError(r.StatusCode(), r.Header()); maintenanceError != nil {
+			retry, err = true, maintenanceError
+		} else {
+			for _, retryConditional := range c.retryConditionals {
+				if retryConditional(r, err) {
+					retry = true
+					break
+				}
 			}
 		}
-		return false
+
+		if !retry {
+			if isMaintenanceResponse(r.StatusCode(), r.Header()) {
+				logMaintenance(logState.get(), r.Request.Method, requestPath(r.Request.URL))
+			}
+
+			return false
+		}
+
+		apiError, _ := r.Error().(*APIError)
+
+		if allowed, reason := retryState.forRequest(r.Request.Context()).allowRetry(
+			r.Request.Method,
+			requestEndpoint(rc.BaseURL, r.Request.URL),
+			r.Request.Attempt,
+			retryStartFromContext(r.Request.Context()),
+			r.StatusCode(),
+			apiError,
+			err,
+		); !allowed {
+			logRetryDenied(logState.get(), r.Request.Method, requestPath(r.Request.URL), r.StatusCode(), r.Request.Attempt, reason)
+			return false
+		}
+
+		logRetry(
+			logState.get(),
+			r.Request.Method,
+			requestPath(r.Request.URL),
+			r.StatusCode(),
+			r.Request.Attempt,
+			retryReason(r.StatusCode(), errorOrNil(apiError), err),
+			r.Header().Get(retryAfterHeaderName),
+		)
+
+		return true
+	}
+}
+
+// retryReason returns a human-readable reason for retrying a request.
+func retryReason(status int, apiError, err error) string {
+	switch {
+	case err != nil:
+		return err.Error()
+	case apiError != nil && apiError.Error() != "":
+		return apiError.Error()
+	default:
+		return http.StatusText(status)
 	}
 }
 
+func logRetry(logger *slog.Logger, method, path string, status, attempt int, reason, retryAfter string) {
+	attrs := []any{
+		"method", method,
+		"path", path,
+		"status", status,
+		"attempt", attempt,
+		"reason", reason,
+	}
+
+	if retryAfter != "" {
+		attrs = append(attrs, "retry_after", retryAfter)
+	}
+
+	logger.Info("Retrying request", attrs...)
+}
+
+func logRetryDenied(logger *slog.Logger, method, path string, status, attempt int, reason string) {
+	logger.Debug(
+		"Not retrying request",
+		"method", method,
+		"path", path,
+		"status", status,
+		"attempt", attempt,
+		"reason", reason,
+	)
+}
+
+// errorOrNil prevents a nil *APIError from being converted to a non-nil error.
+func errorOrNil(apiError *APIError) error {
+	if apiError == nil {
+		return nil
+	}
+
+	return apiError
+}
+
+// isMaintenanceResponse returns whether the given response indicates that
+// the API is under maintenance.
+func isMaintenanceResponse(status int, header http.Header) bool {
+	return status == http.StatusServiceUnavailable && header.Get(maintenanceModeHeaderName) != ""
+}
+
+func logMaintenance(logger *slog.Logger, method, path string) {
+	logger.Warn(
+		"Linode API is under maintenance, request will not be retried - please see status.linode.com for more information",
+		"method", method,
+		"path", path,
+	)
+}
+
 // SetLinodeBusyRetry configures resty to retry specifically on "Linode busy." errors
 // The retry wait time is configured in SetPollDelay
 func linodeBusyRetryCondition(r *resty.Response, _ error) bool {
@@ -66,8 +179,7 @@ func serviceUnavailableRetryCondition(r *resty.Response, _ error) bool {
 	// During maintenance events, the API will return a 503 and add
 	// an `X-MAINTENANCE-MODE` header. Don't retry during maintenance
 	// events, only for legitimate 503s.
-	if serviceUnavailable && r.Header().Get(maintenanceModeHeaderName) != "" {
-		log.Printf("[INFO] Linode API is under maintenance, request will not be retried - please see status.linode.com for more information")
+	if isMaintenanceResponse(r.StatusCode(), r.Header()) {
 		return false
 	}
 
@@ -88,7 +200,7 @@ func requestNGINXRetryCondition(r *resty.Response, _ error) bool {
 		r.Header().Get("Content-Type") == "text/html"
 }
 
-func respectRetryAfter(client *resty.Client, resp *resty.Response) (time.Duration, error) {
+func respectRetryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
 	retryAfterStr := resp.Header().Get(retryAfterHeaderName)
 	if retryAfterStr == "" {
 		return 0, nil
@@ -100,6 +212,5 @@ func respectRetryAfter(client *resty.Client, resp *resty.Response) (time.Duratio
 	}
 
 	duration := time.Duration(retryAfter) * time.Second
-	log.Printf("[INFO] Respecting Retry-After Header of %d (%s) (max %s)", retryAfter, duration, client.RetryMaxWaitTime)
 	return duration, nil
 }
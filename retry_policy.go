@@ -0,0 +1,308 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy configures when and how failed requests are retried.
+//
+// Whether a failed request can be retried at all is determined by the retry
+// conditionals (see AddRetryCondition); the policy determines how many times
+// and how long to wait between attempts.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including
+	// the initial attempt. If zero, the number of attempts is not limited.
+	MaxAttempts int
+
+	// MaxElapsedTime is the maximum time since the initial attempt after which
+	// a request will no longer be retried. If zero, the time is not limited.
+	MaxElapsedTime time.Duration
+
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay between attempts, including delays
+	// requested by the Retry-After header. If zero, delays are not capped.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor the delay is multiplied by after each retry.
+	// Values less than 1 result in a constant delay.
+	Multiplier float64
+
+	// Jitter randomizes each delay by up to the given fraction of the delay,
+	// e.g. 0.2 results in delays between 80% and 120% of the computed delay.
+	Jitter float64
+
+	// Overrides configure the retry behavior of specific methods and routes.
+	// Only the first matching override is applied to a request.
+	Overrides []RetryOverride
+}
+
+// RetryOverride overrides the retry behavior of a RetryPolicy for
+// requests matching the given method and route.
+type RetryOverride struct {
+	// Method is the HTTP method of matching requests.
+	// If empty, all methods will match.
+	Method string
+
+	// Route is a pattern (see path.Match) for the endpoint of matching
+	// requests, e.g. "linode/instances/*/boot". If empty, all routes will match.
+	Route string
+
+	// MaxAttempts overrides the MaxAttempts of the policy if non-zero.
+	MaxAttempts int
+
+	// NonIdempotent prevents matching requests from being retried once
+	// they may have been received by the API. Such requests are only retried
+	// if the API explicitly rejected them (e.g. 429 Too Many Requests) or
+	// if they could not be sent (e.g. a connection could not be established).
+	NonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used by new clients.
+// POST requests are treated as non-idempotent to prevent duplicate
+// resources from being created when a request is retried.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:     defaultRetryCount + 1,
+		InitialInterval: time.Second,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		Overrides: []RetryOverride{
+			{
+				Method:        http.MethodPost,
+				NonIdempotent: true,
+			},
+		},
+	}
+}
+
+// override returns the first override matching the given request, if any.
+func (p *RetryPolicy) override(method, endpoint string) *RetryOverride {
+	for i, o := range p.Overrides {
+		if o.Method != "" && !strings.EqualFold(o.Method, method) {
+			continue
+		}
+
+		if o.Route != "" {
+			if matched, err := path.Match(strings.Trim(o.Route, "/"), endpoint); err != nil || !matched {
+				continue
+			}
+		}
+
+		return &p.Overrides[i]
+	}
+
+	return nil
+}
+
+// allowRetry returns whether a request that has been attempted the
+// given number of times may be retried, and the reason if not.
+func (p *RetryPolicy) allowRetry(
+	method, endpoint string,
+	attempts int,
+	start time.Time,
+	status int,
+	apiError *APIError,
+	err error,
+) (bool, string) {
+	maxAttempts := p.MaxAttempts
+	nonIdempotent := false
+
+	if o := p.override(method, endpoint); o != nil {
+		if o.MaxAttempts != 0 {
+			maxAttempts = o.MaxAttempts
+		}
+
+		nonIdempotent = o.NonIdempotent
+	}
+
+	switch {
+	case maxAttempts > 0 && attempts >= maxAttempts:
+		return false, "maximum attempts reached"
+	case p.MaxElapsedTime > 0 && !start.IsZero() && time.Since(start) >= p.MaxElapsedTime:
+		return false, "maximum elapsed time reached"
+	case nonIdempotent && !requestRejected(status, apiError, err):
+		return false, "non-idempotent request may have been received by the API"
+	}
+
+	return true, ""
+}
+
+// delay returns the delay before the next attempt of a request that has been
+// attempted the given number of times. A positive retryAfter (i.e. from the
+// Retry-After header) takes precedence over the computed backoff.
+func (p *RetryPolicy) delay(attempts int, retryAfter time.Duration) time.Duration {
+	result := retryAfter
+
+	if result <= 0 {
+		multiplier := max(p.Multiplier, 1)
+		backoff := float64(p.InitialInterval) * math.Pow(multiplier, float64(max(attempts-1, 0)))
+
+		if p.MaxInterval > 0 {
+			backoff = math.Min(backoff, float64(p.MaxInterval))
+		}
+
+		if jitter := math.Min(math.Max(p.Jitter, 0), 1); jitter > 0 {
+			backoff *= 1 + jitter*(2*rand.Float64()-1) //nolint:gosec
+		}
+
+		// Guard against overflow for large attempt counts
+		result = time.Duration(math.Min(backoff, math.MaxInt64))
+	}
+
+	if p.MaxInterval > 0 && result > p.MaxInterval {
+		result = p.MaxInterval
+	}
+
+	return result
+}
+
+// requestRejected returns whether the given response or error indicate that a request
+// was not processed by the API, meaning it is safe to retry a non-idempotent request.
+func requestRejected(status int, apiError *APIError, err error) bool {
+	// The API rejects all requests while under maintenance
+	var maintenanceErr *MaintenanceError
+	if errors.As(err, &maintenanceErr) {
+		return true
+	}
+
+	switch {
+	case status == http.StatusTooManyRequests:
+		return true
+	case status == http.StatusBadRequest && apiError != nil && apiError.Error() == "Linode busy.":
+		return true
+	case status != 0:
+		return false
+	}
+
+	// The request could not be sent if a connection was never established
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// clientRetryState holds the retry policy shared between copies of a Client.
+type clientRetryState struct {
+	policy atomic.Pointer[RetryPolicy]
+}
+
+func newClientRetryState() *clientRetryState {
+	state := &clientRetryState{}
+	policy := DefaultRetryPolicy()
+	state.policy.Store(&policy)
+
+	return state
+}
+
+func (s *clientRetryState) get() *RetryPolicy {
+	return s.policy.Load()
+}
+
+// update atomically replaces the policy with a modified copy.
+func (s *clientRetryState) update(f func(*RetryPolicy)) {
+	for {
+		current := s.policy.Load()
+
+		updated := *current
+		f(&updated)
+
+		if s.policy.CompareAndSwap(current, &updated) {
+			return
+		}
+	}
+}
+
+// retryStartKey is the context key of the time of the initial attempt of a request.
+type retryStartKey struct{}
+
+func retryStartFromContext(ctx context.Context) time.Time {
+	start, _ := ctx.Value(retryStartKey{}).(time.Time)
+	return start
+}
+
+// SetRetryPolicy sets the RetryPolicy used to retry failed requests.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	policy.Overrides = append([]RetryOverride(nil), policy.Overrides...)
+	c.retryState.policy.Store(&policy)
+	c.applyRetryPolicy()
+
+	return c
+}
+
+// GetRetryPolicy returns the RetryPolicy used to retry failed requests.
+func (c *Client) GetRetryPolicy() RetryPolicy {
+	policy := *c.retryState.get()
+	policy.Overrides = append([]RetryOverride(nil), policy.Overrides...)
+
+	return policy
+}
+
+// applyRetryPolicy configures resty to defer to the retry policy.
+// Delays are computed by the policy, so resty's own bounds are relaxed.
+func (c *Client) applyRetryPolicy() {
+	policy := c.retryState.get()
+
+	retryCount := math.MaxInt32
+	if policy.MaxAttempts > 0 {
+		retryCount = policy.MaxAttempts - 1
+	}
+
+	// Overrides may allow more attempts than the policy itself
+	for _, o := range policy.Overrides {
+		if o.MaxAttempts > 0 {
+			retryCount = max(retryCount, o.MaxAttempts-1)
+		}
+	}
+
+	maxWaitTime := time.Duration(math.MaxInt64)
+	if policy.MaxInterval > 0 {
+		maxWaitTime = policy.MaxInterval
+	}
+
+	c.resty.
+		SetRetryCount(retryCount).
+		SetRetryWaitTime(0).
+		SetRetryMaxWaitTime(maxWaitTime)
+}
+
+// configureRetryPolicy registers the hook used to track the time of the
+// initial attempt of requests made through resty.
+func (c *Client) configureRetryPolicy() {
+	c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		if req.Attempt <= 1 {
+			req.SetContext(context.WithValue(req.Context(), retryStartKey{}, time.Now()))
+		}
+
+		return nil
+	})
+}
+
+// policyRetryAfter returns the resty RetryAfterFunc that computes
+// the delay between attempts using the retry policy.
+func policyRetryAfter(state *clientRetryState) resty.RetryAfterFunc {
+	return func(client *resty.Client, resp *resty.Response) (time.Duration, error) {
+		retryAfter, err := respectRetryAfter(client, resp)
+		if err != nil {
+			return 0, err
+		}
+
+		return state.forRequest(resp.Request.Context()).delay(resp.Request.Attempt, retryAfter), nil
+	}
+}
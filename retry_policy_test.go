@@ -0,0 +1,97 @@
+package linodego
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     5 * time.Second,
+		Multiplier:      2,
+	}
+
+	require.Equal(t, time.Second, policy.delay(1, 0))
+	require.Equal(t, 2*time.Second, policy.delay(2, 0))
+	require.Equal(t, 4*time.Second, policy.delay(3, 0))
+	require.Equal(t, 5*time.Second, policy.delay(4, 0))
+	require.Equal(t, 5*time.Second, policy.delay(1000, 0))
+
+	// Retry-After takes precedence, but is still capped
+	require.Equal(t, 3*time.Second, policy.delay(1, 3*time.Second))
+	require.Equal(t, 5*time.Second, policy.delay(1, time.Minute))
+
+	policy.Jitter = 0.5
+
+	for range 100 {
+		delay := policy.delay(2, 0)
+		require.GreaterOrEqual(t, delay, time.Second)
+		require.LessOrEqual(t, delay, 3*time.Second)
+	}
+}
+
+func TestRetryPolicy_AllowRetry(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = 3
+	policy.MaxElapsedTime = time.Minute
+	policy.Overrides = append(
+		[]RetryOverride{{Method: http.MethodPost, Route: "linode/instances/*/boot", MaxAttempts: 2}},
+		policy.Overrides...,
+	)
+
+	allowed := func(method, endpoint string, attempts int, start time.Time, status int, err error) bool {
+		result, _ := policy.allowRetry(method, endpoint, attempts, start, status, nil, err)
+		return result
+	}
+
+	now := time.Now()
+
+	require.True(t, allowed(http.MethodGet, "linode/instances", 2, now, http.StatusRequestTimeout, nil))
+	require.False(t, allowed(http.MethodGet, "linode/instances", 3, now, http.StatusRequestTimeout, nil))
+	require.False(t, allowed(http.MethodGet, "linode/instances", 1, now.Add(-time.Hour), http.StatusRequestTimeout, nil))
+
+	// Non-idempotent requests are only retried if they were rejected or never sent
+	require.False(t, allowed(http.MethodPost, "linode/instances", 1, now, http.StatusRequestTimeout, nil))
+	require.True(t, allowed(http.MethodPost, "linode/instances", 1, now, http.StatusTooManyRequests, nil))
+	require.True(t, allowed(http.MethodPost, "linode/instances", 1, now, 0, &net.OpError{Op: "dial"}))
+	require.False(t, allowed(http.MethodPost, "linode/instances", 1, now, 0, &net.OpError{Op: "read"}))
+
+	// The first matching override is applied
+	require.True(t, allowed(http.MethodPost, "linode/instances/123/boot", 1, now, http.StatusRequestTimeout, nil))
+	require.False(t, allowed(http.MethodPost, "linode/instances/123/boot", 2, now, http.StatusRequestTimeout, nil))
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		client.SetRetryWaitTime(0)
+
+		require.Equal(t, time.Duration(0), client.GetRetryPolicy().InitialInterval)
+
+		timeout := httpmock.NewJsonResponderOrPanic(
+			http.StatusRequestTimeout,
+			APIError{Errors: []APIErrorReason{{Reason: "Request timed out"}}},
+		)
+
+		httpmock.RegisterResponder("POST", "=~^.*/linode/instances$",
+			timeout.Then(httpmock.NewJsonResponderOrPanic(200, Instance{ID: 123})))
+		httpmock.RegisterResponder("GET", "=~^.*/linode/instances/123$",
+			timeout.Then(httpmock.NewJsonResponderOrPanic(200, Instance{ID: 123})))
+
+		// Creates should not be retried once they may have been received by the API
+		_, err := client.CreateInstance(context.Background(), InstanceCreateOptions{})
+		require.Error(t, err)
+		require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+		instance, err := client.GetInstance(context.Background(), 123)
+		require.NoError(t, err)
+		require.Equal(t, 123, instance.ID)
+		require.Equal(t, 3, httpmock.GetTotalCallCount())
+	})
+}
@@ -0,0 +1,344 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TelemetryInstrumentationName is the name of the OpenTelemetry
+	// tracer and meter used by the client.
+	TelemetryInstrumentationName = "github.com/linode/linodego"
+
+	telemetryRequestsMetricName = "linodego.client.requests"
+	telemetryDurationMetricName = "linodego.client.request.duration"
+	telemetryRetriesMetricName  = "linodego.client.request.retries"
+
+	// telemetryErrorReasonsKey holds the reasons of the errors returned by the API
+	telemetryErrorReasonsKey = attribute.Key("linode.error.reasons")
+)
+
+// routeIDPlaceholders maps API collections identified by
+// non-numeric IDs to the placeholder used in their routes.
+// Numeric path segments are always templated as {id}.
+var routeIDPlaceholders = map[string]string{
+	"account/availability":               "{region}",
+	"account/betas":                      "{id}",
+	"account/entity-transfers":           "{token}",
+	"account/service-transfers":          "{token}",
+	"account/users":                      "{username}",
+	"betas":                              "{id}",
+	"images":                             "{id}",
+	"linode/kernels":                     "{id}",
+	"linode/types":                       "{id}",
+	"lke/clusters/{id}/pools/{id}/nodes": "{id}",
+	"lke/clusters/{id}/nodes":            "{id}",
+	"lke/versions":                       "{version}",
+	"networking/ips":                     "{address}",
+	"object-storage/buckets":             "{region}",
+	"object-storage/buckets/{region}":    "{label}",
+	"object-storage/clusters":            "{id}",
+	"regions":                            "{id}",
+	"regions/{id}/availability":          "{id}",
+}
+
+// requestRoute returns the templated route of the given API endpoint,
+// e.g. "linode/instances/123/disks" becomes "linode/instances/{id}/disks".
+func requestRoute(endpoint string) string {
+	endpoint, _, _ = strings.Cut(strings.Trim(endpoint, "/"), "?")
+	if endpoint == "" {
+		return endpoint
+	}
+
+	segments := strings.Split(endpoint, "/")
+
+	for i, segment := range segments {
+		if placeholder, ok := routeIDPlaceholders[strings.Join(segments[:i], "/")]; ok {
+			segments[i] = placeholder
+			continue
+		}
+
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// clientTelemetry holds the OpenTelemetry instruments shared between copies of a Client.
+type clientTelemetry struct {
+	mu sync.RWMutex
+
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+	retries  metric.Int64Counter
+}
+
+func newClientTelemetry() *clientTelemetry {
+	t := &clientTelemetry{}
+	t.setTracerProvider(otel.GetTracerProvider())
+	_ = t.setMeterProvider(otel.GetMeterProvider())
+
+	return t
+}
+
+func (t *clientTelemetry) setTracerProvider(provider trace.TracerProvider) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracer = provider.Tracer(TelemetryInstrumentationName, trace.WithInstrumentationVersion(Version))
+}
+
+func (t *clientTelemetry) setMeterProvider(provider metric.MeterProvider) error {
+	meter := provider.Meter(TelemetryInstrumentationName, metric.WithInstrumentationVersion(Version))
+
+	requests, err := meter.Int64Counter(
+		telemetryRequestsMetricName,
+		metric.WithDescription("Number of requests made to the Linode API."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	duration, err := meter.Float64Histogram(
+		telemetryDurationMetricName,
+		metric.WithDescription("Duration of requests made to the Linode API, including retries."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	retries, err := meter.Int64Counter(
+		telemetryRetriesMetricName,
+		metric.WithDescription("Number of retried requests made to the Linode API."),
+		metric.WithUnit("{retry}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.requests, t.duration, t.retries = requests, duration, retries
+
+	return nil
+}
+
+func (t *clientTelemetry) getTracer() trace.Tracer {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.tracer
+}
+
+// SetTracerProvider sets the OpenTelemetry TracerProvider used to create a span for each API request.
+// By default, the global TracerProvider is used.
+func (c *Client) SetTracerProvider(provider trace.TracerProvider) *Client {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	c.telemetry.setTracerProvider(provider)
+
+	return c
+}
+
+// SetMeterProvider sets the OpenTelemetry MeterProvider used to record request count,
+// latency and retry metrics for each API route.
+// By default, the global MeterProvider is used.
+func (c *Client) SetMeterProvider(provider metric.MeterProvider) *Client {
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+
+	if err := c.telemetry.setMeterProvider(provider); err != nil {
+		c.logState.get().Warn("Failed to create OpenTelemetry instruments, metrics will not be recorded", "error", err)
+		_ = c.telemetry.setMeterProvider(noop.NewMeterProvider())
+	}
+
+	return c
+}
+
+// requestTelemetryKey is the context key of the requestTelemetry for an in-flight request.
+type requestTelemetryKey struct{}
+
+// requestTelemetry records the span and metrics of a single API request,
+// including all of its retries.
+type requestTelemetry struct {
+	telemetry *clientTelemetry
+	span      trace.Span
+	start     time.Time
+	method    string
+	route     string
+}
+
+// startRequest starts recording telemetry for a request to the given endpoint.
+func (t *clientTelemetry) startRequest(ctx context.Context, method, endpoint string) (context.Context, *requestTelemetry) {
+	route := requestRoute(endpoint)
+
+	ctx, span := t.getTracer().Start(
+		ctx,
+		method+" "+route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(method),
+			semconv.HTTPRoute(route),
+		),
+	)
+
+	rt := &requestTelemetry{
+		telemetry: t,
+		span:      span,
+		start:     time.Now(),
+		method:    method,
+		route:     route,
+	}
+
+	return context.WithValue(ctx, requestTelemetryKey{}, rt), rt
+}
+
+// requestTelemetryFromContext returns the requestTelemetry started for the given context, if any.
+func requestTelemetryFromContext(ctx context.Context) *requestTelemetry {
+	rt, _ := ctx.Value(requestTelemetryKey{}).(*requestTelemetry)
+	return rt
+}
+
+// end finishes recording telemetry for the request. A status of 0
+// indicates that no response was received.
+func (rt *requestTelemetry) end(status, attempts int, apiError *APIError, err error) {
+	retries := max(attempts-1, 0)
+
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(rt.method),
+		semconv.HTTPRoute(rt.route),
+	}
+
+	if status != 0 {
+		attrs = append(attrs, semconv.HTTPResponseStatusCode(status))
+	}
+
+	rt.span.SetAttributes(attrs...)
+	rt.span.SetAttributes(semconv.HTTPRequestResendCount(retries))
+
+	if apiError != nil && len(apiError.Errors) > 0 {
+		reasons := make([]string, len(apiError.Errors))
+		for i, reason := range apiError.Errors {
+			reasons[i] = reason.Error()
+		}
+
+		rt.span.SetAttributes(telemetryErrorReasonsKey.StringSlice(reasons))
+	}
+
+	switch {
+	case err != nil:
+		rt.span.RecordError(err)
+		rt.span.SetStatus(codes.Error, err.Error())
+	case status >= http.StatusBadRequest:
+		rt.span.SetStatus(codes.Error, http.StatusText(status))
+	}
+
+	rt.span.End()
+
+	rt.telemetry.mu.RLock()
+	defer rt.telemetry.mu.RUnlock()
+
+	// The request context may have been cancelled, but metrics should still be recorded
+	ctx := context.Background()
+	options := metric.WithAttributes(attrs...)
+
+	rt.telemetry.requests.Add(ctx, 1, options)
+	rt.telemetry.duration.Record(ctx, time.Since(rt.start).Seconds(), options)
+
+	if retries > 0 {
+		rt.telemetry.retries.Add(ctx, int64(retries), options)
+	}
+}
+
+// startPollerSpan starts a span wrapping the requests made by a poller
+// so long waits are visible in traces.
+func (c *Client) startPollerSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return c.telemetry.getTracer().Start(
+		ctx,
+		"linodego."+name,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// configureTelemetry registers the hooks used to record telemetry
+// for requests made through resty.
+//
+// Requests made through the net/http execution path are recorded
+// by Client.doRequest and httpClient.doRequest.
+func (c *Client) configureTelemetry() {
+	state := c.telemetry
+	rc := c.resty
+
+	rc.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		// Only the first attempt starts a span, retries are recorded on the same span
+		if req.Attempt > 1 {
+			return nil
+		}
+
+		ctx, _ := state.startRequest(req.Context(), req.Method, requestEndpoint(rc.BaseURL, req.URL))
+		req.SetContext(ctx)
+
+		return nil
+	})
+
+	rc.OnSuccess(func(_ *resty.Client, resp *resty.Response) {
+		rt := requestTelemetryFromContext(resp.Request.Context())
+		if rt == nil {
+			return
+		}
+
+		apiError, _ := resp.Error().(*APIError)
+		rt.end(resp.StatusCode(), resp.Request.Attempt, apiError, nil)
+	})
+
+	rc.OnError(func(req *resty.Request, err error) {
+		rt := requestTelemetryFromContext(req.Context())
+		if rt == nil {
+			return
+		}
+
+		var (
+			status   int
+			apiError *APIError
+		)
+
+		var respErr *resty.ResponseError
+		if errors.As(err, &respErr) && respErr.Response != nil {
+			status = respErr.Response.StatusCode()
+			apiError, _ = respErr.Response.Error().(*APIError)
+			err = respErr.Err
+		}
+
+		rt.end(status, req.Attempt, apiError, err)
+	})
+
+	rc.OnPanic(func(req *resty.Request, err error) {
+		if rt := requestTelemetryFromContext(req.Context()); rt != nil {
+			rt.end(0, req.Attempt, nil, err)
+		}
+	})
+}
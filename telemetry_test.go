@@ -0,0 +1,124 @@
+package linodego
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+
+	return attribute.Value{}, false
+}
+
+func TestRequestRoute(t *testing.T) {
+	for endpoint, expected := range map[string]string{
+		"linode/instances":                      "linode/instances",
+		"/linode/instances/123/disks/456":       "linode/instances/{id}/disks/{id}",
+		"regions/us-east/availability":          "regions/{id}/availability",
+		"object-storage/buckets/us-east/bucket": "object-storage/buckets/{region}/{label}",
+		"lke/clusters/123/nodes/123-abcdef":     "lke/clusters/{id}/nodes/{id}",
+		"networking/ips/192.0.2.1":              "networking/ips/{address}",
+	} {
+		require.Equal(t, expected, requestRoute(endpoint), endpoint)
+	}
+}
+
+func TestClient_Telemetry(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		client.SetRetryWaitTime(0)
+
+		spans := tracetest.NewSpanRecorder()
+		client.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+
+		metrics := sdkmetric.NewManualReader()
+		client.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics)))
+
+		httpmock.RegisterResponder("GET", "=~^.*/linode/instances/123$",
+			httpmock.NewJsonResponderOrPanic(429, APIError{}).Then(
+				httpmock.NewJsonResponderOrPanic(404, APIError{Errors: []APIErrorReason{{Reason: "Not found"}}}),
+			),
+		)
+
+		_, err := client.GetInstance(context.Background(), 123)
+		require.Error(t, err)
+
+		ended := spans.Ended()
+		require.Len(t, ended, 1)
+
+		span := ended[0]
+		require.Equal(t, "GET linode/instances/{id}", span.Name())
+		require.Equal(t, codes.Error, span.Status().Code)
+
+		value, ok := spanAttribute(span, "http.response.status_code")
+		require.True(t, ok)
+		require.EqualValues(t, 404, value.AsInt64())
+
+		value, ok = spanAttribute(span, "http.request.resend_count")
+		require.True(t, ok)
+		require.EqualValues(t, 1, value.AsInt64())
+
+		value, ok = spanAttribute(span, "linode.error.reasons")
+		require.True(t, ok)
+		require.Equal(t, []string{"Not found"}, value.AsStringSlice())
+
+		var data metricdata.ResourceMetrics
+		require.NoError(t, metrics.Collect(context.Background(), &data))
+		require.Len(t, data.ScopeMetrics, 1)
+
+		recorded := make(map[string]metricdata.Aggregation)
+		for _, m := range data.ScopeMetrics[0].Metrics {
+			recorded[m.Name] = m.Data
+		}
+
+		requests := recorded[telemetryRequestsMetricName].(metricdata.Sum[int64])
+		require.Len(t, requests.DataPoints, 1)
+		require.EqualValues(t, 1, requests.DataPoints[0].Value)
+
+		route, ok := requests.DataPoints[0].Attributes.Value("http.route")
+		require.True(t, ok)
+		require.Equal(t, "linode/instances/{id}", route.AsString())
+
+		retries := recorded[telemetryRetriesMetricName].(metricdata.Sum[int64])
+		require.EqualValues(t, 1, retries.DataPoints[0].Value)
+
+		duration := recorded[telemetryDurationMetricName].(metricdata.Histogram[float64])
+		require.EqualValues(t, 1, duration.DataPoints[0].Count)
+	})
+}
+
+func TestClient_TelemetryPollerSpan(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	client.SetPollDelay(time.Millisecond)
+
+	spans := tracetest.NewSpanRecorder()
+	client.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+
+	httpmock.RegisterResponder("GET", "=~^.*/linode/instances/123$",
+		httpmock.NewJsonResponderOrPanic(200, Instance{ID: 123, Status: InstanceRunning}))
+
+	_, err := client.WaitForInstanceStatus(context.Background(), 123, InstanceRunning, 5)
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 2)
+
+	request, poller := ended[0], ended[1]
+	require.Equal(t, "linodego.WaitForInstanceStatus", poller.Name())
+	require.Equal(t, poller.SpanContext().SpanID(), request.Parent().SpanID())
+}
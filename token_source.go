@@ -0,0 +1,217 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/oauth2"
+)
+
+// childTokenRefreshMargin is how long before its expiry a child account token is replaced.
+const childTokenRefreshMargin = time.Minute
+
+// LinodeOAuth2Endpoint is the OAuth2 endpoint of Linode's login service.
+var LinodeOAuth2Endpoint = oauth2.Endpoint{
+	AuthURL:   "https://login.linode.com/oauth/authorize",
+	TokenURL:  "https://login.linode.com/oauth/token",
+	AuthStyle: oauth2.AuthStyleInParams,
+}
+
+// TokenSource provides the API token used to authenticate a request.
+// It is called before every request, so implementations should cache
+// tokens and must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of an ordinary function as a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// NewStaticTokenSource returns a TokenSource that always returns the given token.
+func NewStaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type oauth2TokenSource struct {
+	source oauth2.TokenSource
+}
+
+func (s oauth2TokenSource) Token(context.Context) (string, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+// NewOAuth2TokenSource returns a TokenSource backed by the given oauth2.TokenSource,
+// e.g. one returned by oauth2.Config.TokenSource(...) that refreshes tokens automatically.
+func NewOAuth2TokenSource(source oauth2.TokenSource) TokenSource {
+	return oauth2TokenSource{source: oauth2.ReuseTokenSource(nil, source)}
+}
+
+// NewRefreshTokenSource returns a TokenSource that uses the given refresh token to obtain
+// access tokens from Linode's login service using the given OAuth client credentials.
+// Access tokens are refreshed automatically when they expire.
+func NewRefreshTokenSource(ctx context.Context, clientID, clientSecret, refreshToken string) TokenSource {
+	config := &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Endpoint:     LinodeOAuth2Endpoint,
+	}
+
+	return NewOAuth2TokenSource(config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}))
+}
+
+// childAccountTokenSource mints proxy tokens for a child account using the parent account's client.
+type childAccountTokenSource struct {
+	parent *Client
+	euuid  string
+
+	mu      sync.Mutex
+	token   string
+	expiry  *time.Time
+	pending *childAccountTokenRequest
+}
+
+// childAccountTokenRequest is a request minting a child account token,
+// shared by all callers waiting for a token while it is in flight.
+type childAccountTokenRequest struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// NewChildAccountTokenSource returns a TokenSource that mints proxy tokens for the child account
+// with the given EUUID using CreateChildAccountToken on the given parent account client.
+// Tokens are re-minted automatically shortly before they expire.
+// NOTE: Parent/Child related features may not be generally available.
+func NewChildAccountTokenSource(parent *Client, euuid string) TokenSource {
+	return &childAccountTokenSource{parent: parent, euuid: euuid}
+}
+
+func (s *childAccountTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+
+	if s.token != "" && (s.expiry == nil || time.Until(*s.expiry) > childTokenRefreshMargin) {
+		defer s.mu.Unlock()
+		return s.token, nil
+	}
+
+	// Concurrent callers share a single request, which is not cancelled
+	// if the context of the caller that started it is done
+	req := s.pending
+	if req == nil {
+		req = &childAccountTokenRequest{done: make(chan struct{})}
+		s.pending = req
+
+		go s.mint(context.WithoutCancel(ctx), req)
+	}
+
+	s.mu.Unlock()
+
+	select {
+	case <-req.done:
+		return req.token, req.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// mint creates a token for the child account and completes the given request.
+func (s *childAccountTokenSource) mint(ctx context.Context, req *childAccountTokenRequest) {
+	defer close(req.done)
+
+	token, err := s.parent.CreateChildAccountToken(ctx, s.euuid)
+
+	switch {
+	case err != nil:
+		req.err = fmt.Errorf("failed to create token for child account %s: %w", s.euuid, err)
+	case token.Token == "":
+		req.err = errors.New("no token was returned for child account " + s.euuid)
+	default:
+		req.token = token.Token
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = nil
+
+	if req.err == nil {
+		s.token, s.expiry = token.Token, token.Expiry
+	}
+}
+
+// tokenSourceState holds the TokenSource shared between copies of a Client.
+type tokenSourceState struct {
+	source atomic.Pointer[TokenSource]
+}
+
+func (s *tokenSourceState) authorization(ctx context.Context) (string, error) {
+	source := s.source.Load()
+	if source == nil {
+		return "", nil
+	}
+
+	token, err := (*source).Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get token: %w", err)
+	}
+
+	return "Bearer " + token, nil
+}
+
+// SetTokenSource sets the TokenSource used to authenticate every request from this client,
+// taking precedence over any token set using SetToken. Passing nil removes the TokenSource.
+func (c *Client) SetTokenSource(source TokenSource) *Client {
+	if source == nil {
+		c.tokenSource.source.Store(nil)
+	} else {
+		c.tokenSource.source.Store(&source)
+	}
+
+	return c
+}
+
+// configureTokenSource registers the hooks used to authenticate requests
+// made through both resty and the net/http execution path.
+func (c *Client) configureTokenSource() {
+	state := c.tokenSource
+
+	c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		authorization, err := state.authorization(req.Context())
+		if authorization != "" {
+			req.SetHeader("Authorization", authorization)
+		}
+
+		return err
+	})
+
+	c.http.httpOnBeforeRequest(func(req *http.Request) error {
+		authorization, err := state.authorization(req.Context())
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		return err
+	})
+}
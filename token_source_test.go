@@ -0,0 +1,175 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/internal/testutil"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestClient_TokenSource(t *testing.T) {
+	runForEachTransport(t, func(t *testing.T, client *Client) {
+		var authorization string
+
+		httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/profile"),
+			func(req *http.Request) (*http.Response, error) {
+				authorization = req.Header.Get("Authorization")
+				return httpmock.NewJsonResponse(http.StatusOK, Profile{})
+			})
+
+		client.SetTokenSource(NewStaticTokenSource("static-token"))
+
+		_, err := client.GetProfile(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "Bearer static-token", authorization)
+
+		// Errors from the TokenSource fail the request
+		client.SetTokenSource(TokenSourceFunc(func(context.Context) (string, error) {
+			return "", errors.New("token unavailable")
+		}))
+
+		_, err = client.GetProfile(context.Background())
+		require.ErrorContains(t, err, "token unavailable")
+
+		// SetToken replaces the TokenSource
+		client.SetToken("replaced-token")
+
+		_, err = client.GetProfile(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "Bearer replaced-token", authorization)
+	})
+}
+
+func TestClient_TokenSourceWithProfile(t *testing.T) {
+	file := createTestConfig(t, `
+[default]
+token = profile-token
+`)
+
+	t.Setenv(APIEnvVar, "")
+	t.Setenv(APIConfigEnvVar, file.Name())
+	t.Setenv(APIConfigProfileEnvVar, "default")
+
+	httpClient := &http.Client{}
+	httpmock.ActivateNonDefault(httpClient)
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var authorizations []string
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/profile"),
+		func(req *http.Request) (*http.Response, error) {
+			authorizations = append(authorizations, req.Header.Get("Authorization"))
+			return httpmock.NewJsonResponse(http.StatusOK, Profile{})
+		})
+
+	client, err := NewClientFromEnv(httpClient)
+	require.NoError(t, err)
+
+	client.SetTokenSource(NewStaticTokenSource("source-token"))
+
+	// Lazily loading the profile should not replace the TokenSource
+	for range 2 {
+		_, err = client.GetProfile(context.Background())
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []string{"Bearer source-token", "Bearer source-token"}, authorizations)
+}
+
+func TestChildAccountTokenSource(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	expiry := time.Now().Add(30 * time.Second).UTC().Format("2006-01-02T15:04:05")
+
+	httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/account/child-accounts/child-euuid/token"),
+		httpmock.NewStringResponder(http.StatusOK, `{"token": "child-token", "expiry": "`+expiry+`"}`))
+
+	source := NewChildAccountTokenSource(client, "child-euuid")
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "child-token", token)
+
+	// Tokens that are about to expire are re-minted
+	_, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestChildAccountTokenSource_concurrent(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+	release := make(chan struct{})
+
+	httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/account/child-accounts/child-euuid/token"),
+		func(*http.Request) (*http.Response, error) {
+			<-release
+			return httpmock.NewStringResponse(http.StatusOK, `{"token": "child-token"}`), nil
+		})
+
+	source := NewChildAccountTokenSource(client, "child-euuid")
+
+	// Callers whose context is done stop waiting without failing the other callers
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := source.Token(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	var wg sync.WaitGroup
+
+	tokens := make([]string, 5)
+
+	for i := range tokens {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			token, err := source.Token(context.Background())
+			require.NoError(t, err)
+
+			tokens[i] = token
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, []string{"child-token", "child-token", "child-token", "child-token", "child-token"}, tokens)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestRefreshTokenSource(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("POST", LinodeOAuth2Endpoint.TokenURL,
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, req.ParseForm())
+			require.Equal(t, "refresh_token", req.PostForm.Get("grant_type"))
+			require.Equal(t, "refresh-token", req.PostForm.Get("refresh_token"))
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"access_token": "access-token",
+				"token_type":   "bearer",
+				"expires_in":   3600,
+			})
+		})
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: transport})
+	source := NewRefreshTokenSource(ctx, "client-id", "client-secret", "refresh-token")
+
+	for range 2 {
+		token, err := source.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "access-token", token)
+	}
+
+	// Access tokens are reused until they expire
+	require.Equal(t, 1, transport.GetTotalCallCount())
+}
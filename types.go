@@ -68,35 +68,16 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LinodeType), nil
-	}
-
-	response, err := getPaginatedResults[LinodeType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, endpoint, &cacheExpiryTime, func(ctx context.Context) ([]LinodeType, error) {
+		return getPaginatedResults[LinodeType](ctx, c, e, opts)
+	})
 }
 
 // GetType gets the type with the provided ID. This endpoint is cached by default.
 func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error) {
 	e := formatAPIPath("linode/types/%s", url.PathEscape(typeID))
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LinodeType)
-		return &result, nil
-	}
-
-	response, err := doGETRequest[LinodeType](ctx, c, e)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(e, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, e, &cacheExpiryTime, func(ctx context.Context) (*LinodeType, error) {
+		return doGETRequest[LinodeType](ctx, c, e)
+	})
 }
@@ -132,6 +132,8 @@ func (c *Client) AttachVolume(ctx context.Context, volumeID int, opts *VolumeAtt
 
 // CreateVolume creates a Linode Volume
 func (c *Client) CreateVolume(ctx context.Context, opts VolumeCreateOptions) (*Volume, error) {
+	c.applyProfileDefaults(&opts)
+
 	e := "volumes"
 	response, err := doPOSTRequest[Volume](ctx, c, e, opts)
 	return response, err
@@ -30,16 +30,7 @@ func (c *Client) ListVolumeTypes(ctx context.Context, opts *ListOptions) ([]Volu
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]VolumeType), nil
-	}
-
-	response, err := getPaginatedResults[VolumeType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedRequest(ctx, c, endpoint, &cacheExpiryTime, func(ctx context.Context) ([]VolumeType, error) {
+		return getPaginatedResults[VolumeType](ctx, c, e, opts)
+	})
 }
@@ -3,12 +3,12 @@ package linodego
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"slices"
 	"strconv"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -25,51 +25,51 @@ type EventPoller struct {
 
 	Action EventAction
 
-	client         Client
+	client         *Client
 	previousEvents map[int]bool
 }
 
 // WaitForInstanceStatus waits for the Linode instance to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int, status InstanceStatus, timeoutSeconds int) (*Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			instance, err := client.GetInstance(ctx, instanceID)
-			if err != nil {
-				return instance, err
-			}
-			complete := (instance.Status == status)
-
-			if complete {
-				return instance, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, ctx.Err())
-		}
-	}
+func (client *Client) WaitForInstanceStatus(ctx context.Context, instanceID int, status InstanceStatus, timeoutSeconds int) (*Instance, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForInstanceStatus",
+		attribute.Int("linode.instance.id", instanceID),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	instance, err := Poll(
+		ctx,
+		func(ctx context.Context) (*Instance, error) {
+			return client.GetInstance(ctx, instanceID)
+		},
+		func(instance *Instance) bool {
+			return instance.Status == status
+		},
+		waitForOptions[*Instance](client, timeoutSeconds),
+	)
+
+	return instance, wrapWaitForError(err, "Error waiting for Instance %d status %s", instanceID, status)
 }
 
 // WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeoutSeconds int) (*InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
+func (client *Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeoutSeconds int) (*InstanceDisk, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForInstanceDiskStatus",
+		attribute.Int("linode.instance.id", instanceID),
+		attribute.Int("linode.disk.id", diskID),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	disk, err := Poll(
+		ctx,
+		func(ctx context.Context) (*InstanceDisk, error) {
 			// GetInstanceDisk will 404 on newly created disks. use List instead.
-			// disk, err := client.GetInstanceDisk(ctx, instanceID, diskID)
 			disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
 			if err != nil {
 				return nil, err
@@ -77,132 +77,141 @@ func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID i
 
 			for _, disk := range disks {
 				if disk.ID == diskID {
-					complete := (disk.Status == status)
-					if complete {
-						return &disk, nil
-					}
-
-					break
+					return &disk, nil
 				}
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, ctx.Err())
-		}
+
+			return nil, nil
+		},
+		func(disk *InstanceDisk) bool {
+			return disk != nil && disk.Status == status
+		},
+		waitForOptions[*InstanceDisk](client, timeoutSeconds),
+	)
+	if err != nil {
+		return nil, wrapWaitForError(err, "Error waiting for Instance %d Disk %d status %s", instanceID, diskID, status)
 	}
+
+	return disk, nil
 }
 
 // WaitForVolumeStatus waits for the Volume to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus, timeoutSeconds int) (*Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			volume, err := client.GetVolume(ctx, volumeID)
-			if err != nil {
-				return volume, err
-			}
-			complete := (volume.Status == status)
-
-			if complete {
-				return volume, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, ctx.Err())
-		}
-	}
+func (client *Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus, timeoutSeconds int) (*Volume, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForVolumeStatus",
+		attribute.Int("linode.volume.id", volumeID),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	volume, err := Poll(
+		ctx,
+		func(ctx context.Context) (*Volume, error) {
+			return client.GetVolume(ctx, volumeID)
+		},
+		func(volume *Volume) bool {
+			return volume.Status == status
+		},
+		waitForOptions[*Volume](client, timeoutSeconds),
+	)
+
+	return volume, wrapWaitForError(err, "Error waiting for Volume %d status %s", volumeID, status)
 }
 
 // WaitForSnapshotStatus waits for the Snapshot to reach the desired state
-// before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForSnapshotStatus(ctx context.Context, instanceID int, snapshotID int, status InstanceSnapshotStatus, timeoutSeconds int) (*InstanceSnapshot, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// before returning. It will timeout with an error after timeoutSeconds,
+// or return an error as soon as the Snapshot has failed.
+func (client *Client) WaitForSnapshotStatus(ctx context.Context, instanceID int, snapshotID int, status InstanceSnapshotStatus, timeoutSeconds int) (*InstanceSnapshot, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForSnapshotStatus",
+		attribute.Int("linode.instance.id", instanceID),
+		attribute.Int("linode.snapshot.id", snapshotID),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	opts := waitForOptions[*InstanceSnapshot](client, timeoutSeconds)
+	opts.Failure = func(snapshot *InstanceSnapshot) error {
+		if snapshot.Status == SnapshotFailed {
+			return fmt.Errorf("Instance %d Snapshot %d has failed", instanceID, snapshotID)
+		}
 
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
+		return nil
+	}
 
-	for {
-		select {
-		case <-ticker.C:
-			snapshot, err := client.GetInstanceSnapshot(ctx, instanceID, snapshotID)
-			if err != nil {
-				return snapshot, err
-			}
-			complete := (snapshot.Status == status)
+	snapshot, err := Poll(
+		ctx,
+		func(ctx context.Context) (*InstanceSnapshot, error) {
+			return client.GetInstanceSnapshot(ctx, instanceID, snapshotID)
+		},
+		func(snapshot *InstanceSnapshot) bool {
+			return snapshot.Status == status
+		},
+		opts,
+	)
 
-			if complete {
-				return snapshot, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, ctx.Err())
-		}
-	}
+	return snapshot, wrapWaitForError(err, "Error waiting for Instance %d Snapshot %d status %s", instanceID, snapshotID, status)
 }
 
 // WaitForVolumeLinodeID waits for the Volume to match the desired LinodeID
 // before returning. An active Instance will not immediately attach or detach a volume, so
 // the LinodeID must be polled to determine volume readiness from the API.
 // WaitForVolumeLinodeID will timeout with an error after timeoutSeconds.
-func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, linodeID *int, timeoutSeconds int) (*Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			volume, err := client.GetVolume(ctx, volumeID)
-			if err != nil {
-				return volume, err
-			}
-
+func (client *Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, linodeID *int, timeoutSeconds int) (*Volume, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForVolumeLinodeID",
+		attribute.Int("linode.volume.id", volumeID),
+	)
+	defer span.End()
+
+	volume, err := Poll(
+		ctx,
+		func(ctx context.Context) (*Volume, error) {
+			return client.GetVolume(ctx, volumeID)
+		},
+		func(volume *Volume) bool {
 			switch {
 			case linodeID == nil && volume.LinodeID == nil:
-				return volume, nil
+				return true
 			case linodeID == nil || volume.LinodeID == nil:
-				// continue waiting
-			case *volume.LinodeID == *linodeID:
-				return volume, nil
+				return false
+			default:
+				return *volume.LinodeID == *linodeID
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, ctx.Err())
-		}
-	}
+		},
+		waitForOptions[*Volume](client, timeoutSeconds),
+	)
+
+	return volume, wrapWaitForError(err, "Error waiting for Volume %d to have Instance %v", volumeID, linodeID)
 }
 
 // WaitForLKEClusterStatus waits for the LKECluster to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForLKEClusterStatus(ctx context.Context, clusterID int, status LKEClusterStatus, timeoutSeconds int) (*LKECluster, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			cluster, err := client.GetLKECluster(ctx, clusterID)
-			if err != nil {
-				return cluster, err
-			}
-			complete := (cluster.Status == status)
-
-			if complete {
-				return cluster, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Cluster %d status %s: %w", clusterID, status, ctx.Err())
-		}
-	}
+func (client *Client) WaitForLKEClusterStatus(ctx context.Context, clusterID int, status LKEClusterStatus, timeoutSeconds int) (*LKECluster, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForLKEClusterStatus",
+		attribute.Int("linode.lke_cluster.id", clusterID),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	cluster, err := Poll(
+		ctx,
+		func(ctx context.Context) (*LKECluster, error) {
+			return client.GetLKECluster(ctx, clusterID)
+		},
+		func(cluster *LKECluster) bool {
+			return cluster.Status == status
+		},
+		waitForOptions[*LKECluster](client, timeoutSeconds),
+	)
+
+	return cluster, wrapWaitForError(err, "Error waiting for Cluster %d status %s", clusterID, status)
 }
 
 // LKEClusterPollOptions configures polls against LKE Clusters.
@@ -229,12 +238,19 @@ type ClusterConditionOptions struct {
 type ClusterConditionFunc func(context.Context, ClusterConditionOptions) (bool, error)
 
 // WaitForLKEClusterConditions waits for the given LKE conditions to be true
-func (client Client) WaitForLKEClusterConditions(
+func (client *Client) WaitForLKEClusterConditions(
 	ctx context.Context,
 	clusterID int,
 	options LKEClusterPollOptions,
 	conditions ...ClusterConditionFunc,
 ) error {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForLKEClusterConditions",
+		attribute.Int("linode.lke_cluster.id", clusterID),
+	)
+	defer span.End()
+
 	ctx, cancel := context.WithCancel(ctx)
 	if options.TimeoutSeconds != 0 {
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(options.TimeoutSeconds)*time.Second)
@@ -246,33 +262,38 @@ func (client Client) WaitForLKEClusterConditions(
 		return fmt.Errorf("failed to get Kubeconfig for LKE cluster %d: %w", clusterID, err)
 	}
 
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
 	conditionOptions := ClusterConditionOptions{LKEClusterKubeconfig: lkeKubeConfig, TransportWrapper: options.TransportWrapper}
 
-	for _, condition := range conditions {
-	ConditionSucceeded:
-		for {
-			select {
-			case <-ticker.C:
-				result, err := condition(ctx, conditionOptions)
-				if err != nil {
-					log.Printf("[WARN] Ignoring WaitForLKEClusterConditions conditional error: %s", err)
-					if !options.Retry {
-						return err
-					}
-				}
-
-				if result {
-					break ConditionSucceeded
-				}
+	opts := pollOptions[bool](client)
+	opts.Progress = func(progress PollProgress[bool]) {
+		if progress.Err != nil {
+			client.logState.get().Warn(
+				"Ignoring WaitForLKEClusterConditions conditional error",
+				"cluster_id", clusterID,
+				"error", progress.Err,
+			)
+		}
+	}
+	opts.Retry = func(error) bool {
+		return options.Retry
+	}
 
-			case <-ctx.Done():
-				return fmt.Errorf("Error waiting for cluster %d conditions: %w", clusterID, ctx.Err())
-			}
+	for _, condition := range conditions {
+		_, err := Poll(
+			ctx,
+			func(ctx context.Context) (bool, error) {
+				return condition(ctx, conditionOptions)
+			},
+			func(result bool) bool {
+				return result
+			},
+			opts,
+		)
+		if err != nil {
+			return wrapWaitForError(err, "Error waiting for cluster %d conditions", clusterID)
 		}
 	}
+
 	return nil
 }
 
@@ -280,7 +301,7 @@ func (client Client) WaitForLKEClusterConditions(
 // before returning. It will timeout with an error after timeoutSeconds.
 // If the event indicates a failure both the failed event and the error will be returned.
 // nolint
-func (client Client) WaitForEventFinished(
+func (client *Client) WaitForEventFinished(
 	ctx context.Context,
 	id any,
 	entityType EntityType,
@@ -288,13 +309,24 @@ func (client Client) WaitForEventFinished(
 	minStart time.Time,
 	timeoutSeconds int,
 ) (*Event, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForEventFinished",
+		attribute.String("linode.entity.type", string(entityType)),
+		attribute.String("linode.entity.id", fmt.Sprint(id)),
+		attribute.String("linode.event.action", string(action)),
+	)
+	defer span.End()
+
 	titledEntityType := englishTitle.String(string(entityType))
 	filter := Filter{
 		Order:   Descending,
 		OrderBy: "created",
 	}
-	filter.AddField(Eq, "action", action)
-	filter.AddField(Gte, "created", minStart.UTC().Format("2006-01-02T15:04:05"))
+	filter.Add(
+		EventFilter.Action().Eq(action),
+		EventFilter.Created().Gte(minStart),
+	)
 
 	// Optimistically restrict results to page 1.  We should remove this when more
 	// precise filtering options exist.
@@ -305,13 +337,15 @@ func (client Client) WaitForEventFinished(
 	switch entityType {
 	case EntityDisk, EntityDatabase, EntityLinode, EntityDomain, EntityNodebalancer:
 		// All of the filter supported types have int ids
-		filterableEntityID, err := strconv.Atoi(fmt.Sprintf("%v", id))
+		filterableEntityID, err := eventEntityID(id)
 		if err != nil {
 			return nil, fmt.Errorf("error parsing Entity ID %q for optimized "+
 				"WaitForEventFinished EventType %q: %w", id, entityType, err)
 		}
-		filter.AddField(Eq, "entity.id", filterableEntityID)
-		filter.AddField(Eq, "entity.type", entityType)
+		filter.Add(
+			EventFilter.EntityID().Eq(filterableEntityID),
+			EventFilter.EntityType().Eq(entityType),
+		)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
@@ -319,143 +353,189 @@ func (client Client) WaitForEventFinished(
 
 	if deadline, ok := ctx.Deadline(); ok {
 		duration := time.Until(deadline)
-		log.Printf("[INFO] Waiting %d seconds for %s events since %v for %s %v", int(duration.Seconds()), action, minStart, titledEntityType, id)
+		client.logState.get().Info(
+			"Waiting for events",
+			"timeout", duration.Round(time.Second),
+			"action", action,
+			"since", minStart,
+			"entity_type", entityType,
+			"entity_id", id,
+		)
 	}
 
-	ticker := time.NewTicker(client.pollInterval)
-
 	// avoid repeating log messages
-	nextLog := ""
-	lastLog := ""
+	var nextStatus, lastStatus EventStatus
 	lastEventID := 0
 
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			if lastEventID > 0 {
-				filter.AddField(Gte, "id", lastEventID)
-			}
+	// Returns the first finished or failed event of the entity,
+	// or the latest event of the entity if none have completed
+	fetch := func(ctx context.Context) (*Event, error) {
+		if lastEventID > 0 {
+			filter.Add(EventFilter.ID().Gte(lastEventID))
+		}
 
-			filterStr, err := filter.MarshalJSON()
-			if err != nil {
-				return nil, err
-			}
+		filterStr, err := filter.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
 
-			listOptions := NewListOptions(pages, string(filterStr))
+		listOptions := NewListOptions(pages, string(filterStr))
 
-			events, err := client.ListEvents(ctx, listOptions)
-			if err != nil {
-				return nil, err
-			}
+		events, err := client.ListEvents(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
 
-			// If there are events for this instance + action, inspect them
-			for _, event := range events {
-				event := event
+		var latest *Event
 
-				if event.Entity == nil || event.Entity.Type != entityType {
-					// log.Println("type mismatch", event.Entity.Type, entityType)
-					continue
-				}
+		// If there are events for this instance + action, inspect them
+		for _, event := range events {
+			event := event
 
-				var entID string
+			if event.Entity == nil || event.Entity.Type != entityType {
+				continue
+			}
 
-				switch id := event.Entity.ID.(type) {
-				case float64, float32:
-					entID = fmt.Sprintf("%.f", id)
-				case int:
-					entID = strconv.Itoa(id)
-				default:
-					entID = fmt.Sprintf("%v", id)
-				}
+			var entID string
 
-				var findID string
-				switch id := id.(type) {
-				case float64, float32:
-					findID = fmt.Sprintf("%.f", id)
-				case int:
-					findID = strconv.Itoa(id)
-				default:
-					findID = fmt.Sprintf("%v", id)
-				}
+			switch id := event.Entity.ID.(type) {
+			case float64, float32:
+				entID = fmt.Sprintf("%.f", id)
+			case int:
+				entID = strconv.Itoa(id)
+			default:
+				entID = fmt.Sprintf("%v", id)
+			}
 
-				if entID != findID {
-					// log.Println("id mismatch", entID, findID)
-					continue
-				}
+			var findID string
+			switch id := id.(type) {
+			case float64, float32:
+				findID = fmt.Sprintf("%.f", id)
+			case int:
+				findID = strconv.Itoa(id)
+			default:
+				findID = fmt.Sprintf("%v", id)
+			}
 
-				if event.Created == nil {
-					log.Printf("[WARN] event.Created is nil when API returned: %#+v", event.Created)
-				}
+			if entID != findID {
+				continue
+			}
 
-				// This is the event we are looking for. Save our place.
-				if lastEventID == 0 {
-					lastEventID = event.ID
-				}
+			if event.Created == nil {
+				client.logState.get().Warn("event.Created is nil when returned by the API", "event_id", event.ID)
+			}
 
-				switch event.Status {
-				case EventFailed:
-					return &event, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
-				case EventFinished:
-					log.Printf("[INFO] %s %v action %s is finished", titledEntityType, id, action)
-					return &event, nil
-				}
+			// This is the event we are looking for. Save our place.
+			if lastEventID == 0 {
+				lastEventID = event.ID
+			}
 
-				nextLog = fmt.Sprintf("[INFO] %s %v action %s is %s", titledEntityType, id, action, event.Status)
+			if event.Status == EventFailed || event.Status == EventFinished {
+				return &event, nil
 			}
 
-			// de-dupe logging statements
-			if nextLog != lastLog {
-				log.Print(nextLog)
-				lastLog = nextLog
+			if latest == nil {
+				latest = &event
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, ctx.Err())
+
+			nextStatus = event.Status
 		}
-	}
-}
 
-// WaitForImageStatus waits for the Image to reach the desired state
-// before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForImageStatus(ctx context.Context, imageID string, status ImageStatus, timeoutSeconds int) (*Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+		// de-dupe logging statements
+		if nextStatus != lastStatus {
+			client.logState.get().Info(
+				"Event status changed",
+				"action", action,
+				"entity_type", entityType,
+				"entity_id", id,
+				"status", nextStatus,
+			)
+			lastStatus = nextStatus
+		}
 
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
+		return latest, nil
+	}
 
-	for {
-		select {
-		case <-ticker.C:
-			image, err := client.GetImage(ctx, imageID)
-			if err != nil {
-				return image, err
-			}
-			complete := image.Status == status
+	opts := pollOptions[*Event](client)
+	opts.Failure = func(event *Event) error {
+		if event != nil && event.Status == EventFailed {
+			return fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
+		}
 
-			if complete {
-				return image, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
+		return nil
+	}
+
+	event, err := Poll(
+		ctx,
+		fetch,
+		func(event *Event) bool {
+			return event != nil && event.Status == EventFinished
+		},
+		opts,
+	)
+	if err != nil {
+		if event != nil && event.Status == EventFailed {
+			return event, err
 		}
+
+		return nil, wrapWaitForError(
+			err, "Error waiting for Event Status '%s' of %s %v action '%s'", EventFinished, titledEntityType, id, action,
+		)
 	}
+
+	client.logState.get().Info(
+		"Event finished",
+		"action", action,
+		"entity_type", entityType,
+		"entity_id", id,
+	)
+
+	return event, nil
+}
+
+// WaitForImageStatus waits for the Image to reach the desired state
+// before returning. It will timeout with an error after timeoutSeconds.
+func (client *Client) WaitForImageStatus(ctx context.Context, imageID string, status ImageStatus, timeoutSeconds int) (*Image, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForImageStatus",
+		attribute.String("linode.image.id", imageID),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	image, err := Poll(
+		ctx,
+		func(ctx context.Context) (*Image, error) {
+			return client.GetImage(ctx, imageID)
+		},
+		func(image *Image) bool {
+			return image.Status == status
+		},
+		waitForOptions[*Image](client, timeoutSeconds),
+	)
+
+	return image, wrapWaitForError(err, "failed to wait for Image %s status %s", imageID, status)
 }
 
 // WaitForImageRegionStatus waits for an Image's replica to reach the desired state
 // before returning.
-func (client Client) WaitForImageRegionStatus(ctx context.Context, imageID, region string, status ImageRegionStatus) (*Image, error) {
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			image, err := client.GetImage(ctx, imageID)
-			if err != nil {
-				return image, err
-			}
-
+func (client *Client) WaitForImageRegionStatus(ctx context.Context, imageID, region string, status ImageRegionStatus) (*Image, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForImageRegionStatus",
+		attribute.String("linode.image.id", imageID),
+		attribute.String("linode.region", region),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	image, err := Poll(
+		ctx,
+		func(ctx context.Context) (*Image, error) {
+			return client.GetImage(ctx, imageID)
+		},
+		func(image *Image) bool {
 			replicaIdx := slices.IndexFunc(
 				image.Regions,
 				func(r ImageRegion) bool {
@@ -464,29 +544,27 @@ func (client Client) WaitForImageRegionStatus(ctx context.Context, imageID, regi
 			)
 
 			// If no replica was found or the status doesn't match, try again
-			if replicaIdx < 0 || image.Regions[replicaIdx].Status != status {
-				continue
-			}
+			return replicaIdx >= 0 && image.Regions[replicaIdx].Status == status
+		},
+		pollOptions[*Image](client),
+	)
 
-			return image, nil
-
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
-		}
-	}
+	return image, wrapWaitForError(err, "failed to wait for Image %s status %s", imageID, status)
 }
 
 // WaitForMySQLDatabaseBackup waits for the backup with the given label to be available.
-func (client Client) WaitForMySQLDatabaseBackup(ctx context.Context, dbID int, label string, timeoutSeconds int) (*MySQLDatabaseBackup, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
+func (client *Client) WaitForMySQLDatabaseBackup(ctx context.Context, dbID int, label string, timeoutSeconds int) (*MySQLDatabaseBackup, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForMySQLDatabaseBackup",
+		attribute.Int("linode.database.id", dbID),
+		attribute.String("linode.backup.label", label),
+	)
+	defer span.End()
+
+	backup, err := Poll(
+		ctx,
+		func(ctx context.Context) (*MySQLDatabaseBackup, error) {
 			backups, err := client.ListMySQLDatabaseBackups(ctx, dbID, nil)
 			if err != nil {
 				return nil, err
@@ -497,23 +575,34 @@ func (client Client) WaitForMySQLDatabaseBackup(ctx context.Context, dbID int, l
 					return &backup, nil
 				}
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for backup %s: %w", label, ctx.Err())
-		}
+
+			return nil, nil
+		},
+		func(backup *MySQLDatabaseBackup) bool {
+			return backup != nil
+		},
+		waitForOptions[*MySQLDatabaseBackup](client, timeoutSeconds),
+	)
+	if err != nil {
+		return nil, wrapWaitForError(err, "failed to wait for backup %s", label)
 	}
+
+	return backup, nil
 }
 
 // WaitForPostgresDatabaseBackup waits for the backup with the given label to be available.
-func (client Client) WaitForPostgresDatabaseBackup(ctx context.Context, dbID int, label string, timeoutSeconds int) (*PostgresDatabaseBackup, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
+func (client *Client) WaitForPostgresDatabaseBackup(ctx context.Context, dbID int, label string, timeoutSeconds int) (*PostgresDatabaseBackup, error) {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForPostgresDatabaseBackup",
+		attribute.Int("linode.database.id", dbID),
+		attribute.String("linode.backup.label", label),
+	)
+	defer span.End()
+
+	backup, err := Poll(
+		ctx,
+		func(ctx context.Context) (*PostgresDatabaseBackup, error) {
 			backups, err := client.ListPostgresDatabaseBackups(ctx, dbID, nil)
 			if err != nil {
 				return nil, err
@@ -524,16 +613,25 @@ func (client Client) WaitForPostgresDatabaseBackup(ctx context.Context, dbID int
 					return &backup, nil
 				}
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for backup %s: %w", label, ctx.Err())
-		}
+
+			return nil, nil
+		},
+		func(backup *PostgresDatabaseBackup) bool {
+			return backup != nil
+		},
+		waitForOptions[*PostgresDatabaseBackup](client, timeoutSeconds),
+	)
+	if err != nil {
+		return nil, wrapWaitForError(err, "failed to wait for backup %s", label)
 	}
+
+	return backup, nil
 }
 
-type databaseStatusFunc func(ctx context.Context, client Client, dbID int) (DatabaseStatus, error)
+type databaseStatusFunc func(ctx context.Context, client *Client, dbID int) (DatabaseStatus, error)
 
 var databaseStatusHandlers = map[DatabaseEngineType]databaseStatusFunc{
-	DatabaseEngineTypeMySQL: func(ctx context.Context, client Client, dbID int) (DatabaseStatus, error) {
+	DatabaseEngineTypeMySQL: func(ctx context.Context, client *Client, dbID int) (DatabaseStatus, error) {
 		db, err := client.GetMySQLDatabase(ctx, dbID)
 		if err != nil {
 			return "", err
@@ -541,7 +639,7 @@ var databaseStatusHandlers = map[DatabaseEngineType]databaseStatusFunc{
 
 		return db.Status, nil
 	},
-	DatabaseEngineTypePostgres: func(ctx context.Context, client Client, dbID int) (DatabaseStatus, error) {
+	DatabaseEngineTypePostgres: func(ctx context.Context, client *Client, dbID int) (DatabaseStatus, error) {
 		db, err := client.GetPostgresDatabase(ctx, dbID)
 		if err != nil {
 			return "", err
@@ -551,41 +649,56 @@ var databaseStatusHandlers = map[DatabaseEngineType]databaseStatusFunc{
 	},
 }
 
-// WaitForDatabaseStatus waits for the provided database to have the given status.
-func (client Client) WaitForDatabaseStatus(
+// WaitForDatabaseStatus waits for the provided database to have the given status,
+// returning an error as soon as the database has failed.
+func (client *Client) WaitForDatabaseStatus(
 	ctx context.Context, dbID int, dbEngine DatabaseEngineType, status DatabaseStatus, timeoutSeconds int,
 ) error {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForDatabaseStatus",
+		attribute.Int("linode.database.id", dbID),
+		attribute.String("linode.database.engine", string(dbEngine)),
+		attribute.String("linode.status", string(status)),
+	)
+	defer span.End()
+
+	statusHandler, ok := databaseStatusHandlers[dbEngine]
+	if !ok {
+		return fmt.Errorf("invalid db engine: %s", dbEngine)
+	}
+
+	opts := waitForOptions[DatabaseStatus](client, timeoutSeconds)
+	opts.Failure = func(currentStatus DatabaseStatus) error {
+		if currentStatus == DatabaseStatusFailed {
+			return fmt.Errorf("database %d has failed", dbID)
+		}
 
-	for {
-		select {
-		case <-ticker.C:
-			statusHandler, ok := databaseStatusHandlers[dbEngine]
-			if !ok {
-				return fmt.Errorf("invalid db engine: %s", dbEngine)
-			}
+		return nil
+	}
 
+	_, err := Poll(
+		ctx,
+		func(ctx context.Context) (DatabaseStatus, error) {
 			currentStatus, err := statusHandler(ctx, client, dbID)
 			if err != nil {
-				return fmt.Errorf("failed to get db status: %w", err)
+				return "", fmt.Errorf("failed to get db status: %w", err)
 			}
 
-			if currentStatus == status {
-				return nil
-			}
-		case <-ctx.Done():
-			return fmt.Errorf("failed to wait for database %d status: %w", dbID, ctx.Err())
-		}
-	}
+			return currentStatus, nil
+		},
+		func(currentStatus DatabaseStatus) bool {
+			return currentStatus == status
+		},
+		opts,
+	)
+
+	return wrapWaitForError(err, "failed to wait for database %d status", dbID)
 }
 
 // NewEventPoller initializes a new Linode event poller. This should be run before the event is triggered as it stores
 // the previous state of the entity's events.
-func (client Client) NewEventPoller(
+func (client *Client) NewEventPoller(
 	ctx context.Context, id any, entityType EntityType, action EventAction,
 ) (*EventPoller, error) {
 	result := EventPoller{
@@ -605,7 +718,7 @@ func (client Client) NewEventPoller(
 
 // NewEventPollerWithSecondary initializes a new Linode event poller with for events with a
 // specific secondary entity.
-func (client Client) NewEventPollerWithSecondary(
+func (client *Client) NewEventPollerWithSecondary(
 	ctx context.Context, id any, primaryEntityType EntityType, secondaryID int, action EventAction,
 ) (*EventPoller, error) {
 	poller, err := client.NewEventPoller(ctx, id, primaryEntityType, action)
@@ -625,7 +738,7 @@ func (client Client) NewEventPollerWithSecondary(
 // inst, _ := client.CreateInstance(...)
 // p.EntityID = inst.ID
 // ...
-func (client Client) NewEventPollerWithoutEntity(entityType EntityType, action EventAction) (*EventPoller, error) {
+func (client *Client) NewEventPollerWithoutEntity(entityType EntityType, action EventAction) (*EventPoller, error) {
 	result := EventPoller{
 		EntityType:     entityType,
 		Action:         action,
@@ -641,15 +754,7 @@ func (client Client) NewEventPollerWithoutEntity(entityType EntityType, action E
 // PreTask stores all current events for the given entity to prevent them from being
 // processed on subsequent runs.
 func (p *EventPoller) PreTask(ctx context.Context) error {
-	f := Filter{
-		OrderBy: "created",
-		Order:   Descending,
-	}
-	f.AddField(Eq, "entity.type", p.EntityType)
-	f.AddField(Eq, "entity.id", p.EntityID)
-	f.AddField(Eq, "action", p.Action)
-
-	fBytes, err := f.MarshalJSON()
+	fBytes, err := p.eventFilter()
 	if err != nil {
 		return err
 	}
@@ -673,18 +778,7 @@ func (p *EventPoller) PreTask(ctx context.Context) error {
 }
 
 func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, error) {
-	ticker := time.NewTicker(p.client.pollInterval)
-	defer ticker.Stop()
-
-	f := Filter{
-		OrderBy: "created",
-		Order:   Descending,
-	}
-	f.AddField(Eq, "entity.type", p.EntityType)
-	f.AddField(Eq, "entity.id", p.EntityID)
-	f.AddField(Eq, "action", p.Action)
-
-	fBytes, err := f.MarshalJSON()
+	fBytes, err := p.eventFilter()
 	if err != nil {
 		return nil, err
 	}
@@ -694,9 +788,9 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 		PageOptions: &PageOptions{Page: 1},
 	}
 
-	for {
-		select {
-		case <-ticker.C:
+	event, err := Poll(
+		ctx,
+		func(ctx context.Context) (*Event, error) {
 			events, err := p.client.ListEvents(ctx, &listOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to list events: %w", err)
@@ -715,71 +809,124 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 					return &event, nil
 				}
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for event: %w", ctx.Err())
-		}
+
+			return nil, nil
+		},
+		func(event *Event) bool {
+			return event != nil
+		},
+		pollOptions[*Event](p.client),
+	)
+	if err != nil {
+		return nil, wrapWaitForError(err, "failed to wait for event")
+	}
+
+	return event, nil
+}
+
+// eventFilter returns the filter matching the events of the poller, newest first.
+func (p *EventPoller) eventFilter() ([]byte, error) {
+	entityID, err := eventEntityID(p.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Entity ID %v: %w", p.EntityID, err)
+	}
+
+	f := Filter{
+		OrderBy: "created",
+		Order:   Descending,
 	}
+	f.Add(
+		EventFilter.EntityID().Eq(entityID),
+		EventFilter.EntityType().Eq(p.EntityType),
+		EventFilter.Action().Eq(p.Action),
+	)
+
+	return f.MarshalJSON()
 }
 
 // WaitForFinished waits for a new event to be finished.
 func (p *EventPoller) WaitForFinished(
 	ctx context.Context, timeoutSeconds int,
 ) (*Event, error) {
+	ctx, span := p.client.startPollerSpan(
+		ctx,
+		"EventPoller.WaitForFinished",
+		attribute.String("linode.entity.type", string(p.EntityType)),
+		attribute.String("linode.entity.id", fmt.Sprint(p.EntityID)),
+		attribute.String("linode.event.action", string(p.Action)),
+	)
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(p.client.pollInterval)
-	defer ticker.Stop()
-
 	event, err := p.WaitForLatestUnknownEvent(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to wait for event: %w", err)
 	}
 
-	for {
-		select {
-		case <-ticker.C:
+	opts := pollOptions[*Event](p.client)
+	opts.Failure = func(event *Event) error {
+		if event.Status == EventFailed {
+			return fmt.Errorf("event %d has failed", event.ID)
+		}
+
+		return nil
+	}
+
+	event, err = Poll(
+		ctx,
+		func(ctx context.Context) (*Event, error) {
 			event, err := p.client.GetEvent(ctx, event.ID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get event: %w", err)
 			}
 
-			switch event.Status {
-			case EventFinished:
-				return event, nil
-			case EventFailed:
-				return nil, fmt.Errorf("event %d has failed", event.ID)
-			case EventScheduled, EventStarted, EventNotification:
-				continue
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for event finished: %w", ctx.Err())
-		}
+			return event, nil
+		},
+		func(event *Event) bool {
+			return event.Status == EventFinished
+		},
+		opts,
+	)
+	if err != nil {
+		return nil, wrapWaitForError(err, "failed to wait for event finished")
 	}
+
+	return event, nil
 }
 
 // WaitForResourceFree waits for a resource to have no running events.
-func (client Client) WaitForResourceFree(
+func (client *Client) WaitForResourceFree(
 	ctx context.Context, entityType EntityType, entityID any, timeoutSeconds int,
 ) error {
+	ctx, span := client.startPollerSpan(
+		ctx,
+		"WaitForResourceFree",
+		attribute.String("linode.entity.type", string(entityType)),
+		attribute.String("linode.entity.id", fmt.Sprint(entityID)),
+	)
+	defer span.End()
+
+	filterableEntityID, err := eventEntityID(entityID)
+	if err != nil {
+		return fmt.Errorf("error parsing Entity ID %v: %w", entityID, err)
+	}
+
 	apiFilter := Filter{
 		Order:   Descending,
 		OrderBy: "created",
 	}
-	apiFilter.AddField(Eq, "entity.id", entityID)
-	apiFilter.AddField(Eq, "entity.type", entityType)
+	apiFilter.Add(
+		EventFilter.EntityID().Eq(filterableEntityID),
+		EventFilter.EntityType().Eq(entityType),
+	)
 
 	filterStr, err := apiFilter.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("failed to create filter: %s", err)
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
-
 	// A helper function to determine whether a resource is busy
 	checkIsBusy := func(events []Event) bool {
 		for _, event := range events {
@@ -791,24 +938,25 @@ func (client Client) WaitForResourceFree(
 		return false
 	}
 
-	for {
-		select {
-		case <-ticker.C:
+	_, err = Poll(
+		ctx,
+		func(ctx context.Context) ([]Event, error) {
 			events, err := client.ListEvents(ctx, &ListOptions{
 				Filter: string(filterStr),
 			})
 			if err != nil {
-				return fmt.Errorf("failed to list events: %s", err)
+				return nil, fmt.Errorf("failed to list events: %s", err)
 			}
 
-			if !checkIsBusy(events) {
-				return nil
-			}
+			return events, nil
+		},
+		func(events []Event) bool {
+			return !checkIsBusy(events)
+		},
+		waitForOptions[[]Event](client, timeoutSeconds),
+	)
 
-		case <-ctx.Done():
-			return fmt.Errorf("failed to wait for resource free: %s", ctx.Err())
-		}
-	}
+	return wrapWaitForError(err, "failed to wait for resource free")
 }
 
 // eventMatchesSecondary returns whether the given event's secondary entity
@@ -830,3 +978,13 @@ func eventMatchesSecondary(configuredID any, e Event) bool {
 
 	return secondaryID == configuredID
 }
+
+// eventEntityID returns the given entity ID of an event as an int,
+// which it must be or a string containing one.
+func eventEntityID(id any) (int, error) {
+	if id, ok := id.(int); ok {
+		return id, nil
+	}
+
+	return strconv.Atoi(fmt.Sprint(id))
+}